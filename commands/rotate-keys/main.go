@@ -0,0 +1,117 @@
+package main
+
+import (
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/tsocial/tessellate/storage/consul"
+	"github.com/tsocial/tessellate/storage/envelope"
+	"github.com/tsocial/tessellate/storage/types"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const Version = "0.0.1"
+
+var (
+	consulAddr = kingpin.Flag("consul-addr", "Consul address").Default("127.0.0.1:8500").
+			OverrideDefaultFromEnvar("CONSUL_ADDR").String()
+	kekFile = kingpin.Flag("kek-file", "File of base64 keys, one per line. The first one encrypts, the rest decrypt.").
+		Envar("TSL8_KEK_FILE").String()
+	kek = kingpin.Flag("kek", "Comma separated base64 keys. The first one encrypts, the rest decrypt.").
+		Envar("TSL8_KEK").String()
+	encryptState = kingpin.Flag("encrypt-state", "Encrypt the state of Layouts that is not encrypted yet.").
+			Envar("TSL8_ENCRYPT_STATE").Bool()
+)
+
+// children lists the names of the nodes right under a prefix.
+func children(s *envelope.EnvelopeStore, prefix string) ([]string, error) {
+	keys, err := s.GetKeys(prefix+"/", "/")
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, k := range keys {
+		if !strings.HasSuffix(k, "/") {
+			continue
+		}
+
+		names = append(names, filepath.Base(k))
+	}
+
+	return names, nil
+}
+
+// rotate re-encrypts every version of the Vars of every Workspace and Layout with the
+// first key, along with the state of Layouts and the Plans of Jobs that are encrypted.
+// Vars that are not encrypted yet get encrypted, state and Plans only with --encrypt-state.
+func rotate(s *envelope.EnvelopeStore) (int, error) {
+	total := 0
+
+	wIDs, err := children(s, types.WORKSPACE)
+	if err != nil {
+		return total, err
+	}
+
+	for _, wID := range wIDs {
+		n, err := s.RotateVars(types.MakeTree(wID))
+		if err != nil {
+			return total, err
+		}
+		total += n
+
+		if n, err = s.RotatePlans(wID); err != nil {
+			return total, err
+		}
+		total += n
+
+		lIDs, err := children(s, filepath.Join(types.WORKSPACE, wID, types.LAYOUT))
+		if err != nil {
+			return total, err
+		}
+
+		for _, lID := range lIDs {
+			n, err := s.RotateVars(types.MakeTree(wID, lID))
+			if err != nil {
+				return total, err
+			}
+			total += n
+
+			if n, err = s.RotateState(wID, lID); err != nil {
+				return total, err
+			}
+			total += n
+		}
+	}
+
+	return total, nil
+}
+
+func main() {
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+
+	kingpin.Version(Version)
+	kingpin.Parse()
+
+	keys, err := envelope.LoadKeyring(*kekFile, *kek)
+	if err != nil {
+		log.Fatalf("Cannot load keys: %+v", err)
+	}
+
+	if keys == nil {
+		log.Fatal("No keys given, see --kek-file and --kek")
+	}
+
+	store := envelope.Wrap(consul.MakeConsulStore(*consulAddr), keys)
+	if *encryptState {
+		store.EncryptState()
+	}
+	store.Setup()
+
+	n, err := rotate(store)
+	log.Printf("Rotated %d values", n)
+	if err != nil {
+		log.Fatalf("Cannot rotate keys: %+v", err)
+	}
+}
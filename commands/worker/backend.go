@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage"
+)
+
+// stateBackend serves the state of a Layout to Terraform, as an HTTP backend, so that it
+// is read and written through the Storer, which encrypts it, rather than by Terraform
+// straight from Consul.
+// Terraform may only lock, write or delete the state while the Job holds the Lock of the
+// Layout. An abort, or a forced unlock, hands the Layout to the next Job while this one
+// may still be running, which must not write over the state of the next.
+type stateBackend struct {
+	store storage.Storer
+	key   string
+	in    *input
+}
+
+const (
+	lockMethod   = "LOCK"
+	unlockMethod = "UNLOCK"
+)
+
+func (b *stateBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		held, err := holdsLayout(b.store, b.in)
+		if err != nil {
+			log.Printf("Cannot get lock of %v: %+v", b.key, err)
+			http.Error(w, "Cannot get lock", http.StatusInternalServerError)
+			return
+		}
+
+		if !held {
+			http.Error(w, fmt.Sprintf("Layout is no longer held by Job %v", b.in.jobID), http.StatusLocked)
+			return
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		state, err := b.store.GetKey(b.key)
+		if err != nil {
+			log.Printf("Cannot get state %v: %+v", b.key, err)
+			http.Error(w, "Cannot get state", http.StatusInternalServerError)
+			return
+		}
+
+		// Terraform takes this for a Layout that has no state yet.
+		if len(state) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(state)
+
+	case http.MethodPost:
+		state, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "Cannot read state", http.StatusBadRequest)
+			return
+		}
+
+		if err := b.store.SaveKey(b.key, state); err != nil {
+			log.Printf("Cannot save state %v: %+v", b.key, err)
+			http.Error(w, "Cannot save state", http.StatusInternalServerError)
+		}
+
+	case http.MethodDelete:
+		if err := b.store.DeleteKey(b.key); err != nil {
+			log.Printf("Cannot delete state %v: %+v", b.key, err)
+			http.Error(w, "Cannot delete state", http.StatusInternalServerError)
+		}
+
+	case lockMethod, unlockMethod:
+		// The Lock of the Layout, that the Job holds, is the lock of the state.
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveState of a Layout on the loopback interface, for as long as the Job runs.
+// Returns the URL of the backend and a func to stop serving it.
+func serveState(store storage.Storer, in *input) (string, func(), error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", nil, errors.Wrap(err, "Cannot listen for the state backend")
+	}
+
+	srv := &http.Server{Handler: &stateBackend{store: store, key: remotePath(in), in: in}}
+	go srv.Serve(lis)
+
+	return fmt.Sprintf("http://%v/", lis.Addr()), func() { srv.Close() }, nil
+}
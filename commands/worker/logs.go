@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/tsocial/tessellate/redact"
+	"github.com/tsocial/tessellate/storage"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+const (
+	// Size of the buffer after which a chunk is saved right away.
+	logChunkSize = 32 * 1024
+
+	// Interval after which the buffer is saved, even if not full, so that the output
+	// can be followed while the Job runs.
+	logFlushInterval = time.Second
+)
+
+// logWriter saves whatever is written to it in chunks, under the Job's logs.
+// Failing to save a chunk is logged but doesn't fail the Job.
+// Secrets are redacted a line at a time, and a private key only once it has ended, so
+// that a secret written in two pieces is still redacted.
+type logWriter struct {
+	store    storage.Storer
+	tree     *types.Tree
+	job      *types.Job
+	redactor *redact.Redactor
+
+	mu      sync.Mutex
+	pending []byte // Written, yet to be redacted.
+	buf     bytes.Buffer
+	seq     int
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newLogWriter(store storage.Storer, j *types.Job, in *input) *logWriter {
+	w := &logWriter{
+		store:    store,
+		tree:     types.MakeTree(in.workspaceID),
+		job:      j,
+		redactor: in.redactor,
+		done:     make(chan struct{}),
+	}
+
+	w.wg.Add(1)
+	go w.tick()
+
+	return w
+}
+
+func (w *logWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pending = append(w.pending, b...)
+
+	n := redact.Complete(w.pending)
+	if n == 0 && len(w.pending) >= logChunkSize {
+		// Not a line in sight, it cannot be held back for ever.
+		n = len(w.pending)
+	}
+
+	w.redact(n)
+	if w.buf.Len() >= logChunkSize {
+		w.flush()
+	}
+
+	return len(b), nil
+}
+
+// Close stops the ticker and saves whatever is left in the buffer.
+func (w *logWriter) Close() error {
+	close(w.done)
+	w.wg.Wait()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.redact(len(w.pending))
+	w.flush()
+	return nil
+}
+
+// redact the first n bytes of what is pending, into the buffer.
+// Must be called with the mutex held.
+func (w *logWriter) redact(n int) {
+	if n == 0 {
+		return
+	}
+
+	w.buf.Write(w.redactor.Text(w.pending[:n]))
+	w.pending = append(w.pending[:0], w.pending[n:]...)
+}
+
+func (w *logWriter) tick() {
+	defer w.wg.Done()
+
+	t := time.NewTicker(logFlushInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			w.mu.Lock()
+			w.flush()
+			w.mu.Unlock()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// flush saves the buffer as the next chunk. Must be called with the mutex held.
+func (w *logWriter) flush() {
+	if w.buf.Len() == 0 {
+		return
+	}
+
+	if err := w.store.SaveKey(w.job.LogKey(w.tree, w.seq), w.buf.Bytes()); err != nil {
+		log.Printf("Cannot save logs of Job %v: %v", w.job.Id, err)
+		return
+	}
+
+	w.seq++
+	w.buf.Reset()
+}
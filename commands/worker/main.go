@@ -8,21 +8,28 @@ import (
 	"log"
 	"net/http"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
 	"os"
 
 	"net/url"
+	"os/signal"
 	"sync"
+	"syscall"
 
 	"github.com/meson10/highbrow"
 	"github.com/meson10/pester"
 	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/redact"
 	"github.com/tsocial/tessellate/runner"
+	"github.com/tsocial/tessellate/secrets"
 	"github.com/tsocial/tessellate/storage"
 	"github.com/tsocial/tessellate/storage/consul"
+	"github.com/tsocial/tessellate/storage/envelope"
 	"github.com/tsocial/tessellate/storage/types"
+	"github.com/tsocial/tessellate/tmpl"
 	"gopkg.in/alecthomas/kingpin.v2"
 )
 
@@ -36,6 +43,17 @@ var (
 	consulIP    = kingpin.Flag("consul-host", "Consul IP").Short('c').Envar("TSL8_WORKER_CONSUL_IP").String()
 	tmpDir      = kingpin.Flag("tmp-dir", "Temporary Dir").Short('d').Default("test-runner").String()
 	defaultHook = kingpin.Flag("default-hook", "URL which is triggered on successful apply.").URL()
+	kekFile     = kingpin.Flag("kek-file", "File of base64 keys that vars are encrypted with, one per line.").
+			Envar("TSL8_KEK_FILE").String()
+	kek          = kingpin.Flag("kek", "Comma separated base64 keys that vars are encrypted with.").Envar("TSL8_KEK").String()
+	encryptState = kingpin.Flag("encrypt-state", "Encrypt the state, served to Terraform by the worker itself.").
+			Envar("TSL8_ENCRYPT_STATE").Bool()
+	secretsDir = kingpin.Flag("secrets-dir", "Directory that secret://file/<name> references in vars are read from.").
+			Default(secrets.DefaultDir).Envar("TSL8_SECRETS_DIR").String()
+	redactKeys = kingpin.Flag("redact-key", "Pattern of keys whose values are secrets, redacted in logs and callbacks. Repeat for more, replaces the defaults.").
+			Strings()
+	redactValues = kingpin.Flag("redact-value", "Regex of values that are secrets, redacted in logs and callbacks. Repeat for more, replaces the defaults.").
+			Strings()
 )
 
 type input struct {
@@ -43,11 +61,20 @@ type input struct {
 	workspaceID string
 	layoutID    string
 	tmpDir      string
+
+	// Serve the state to Terraform through the Storer, instead of Terraform reading it from Consul.
+	serveState bool
+
+	// Redacts the logs saved and the state sent to callbacks. Default rules if nil.
+	redactor *redact.Redactor
 }
 
 type watchPacket struct {
 	OldState interface{} `json:"old_state"`
 	NewState interface{} `json:"new_state"`
+
+	// Result of a drift check, sent only by Jobs that check for drift.
+	Drift *types.Drift `json:"drift,omitempty"`
 }
 
 // Make a HTTP Call to the callbacks specified.
@@ -79,12 +106,120 @@ func makeCall(req *http.Request) error {
 
 // getJob details.
 func getJob(store storage.Storer, in *input) (*types.Job, error) {
-	j := types.Job{Id: in.jobID, LayoutId: in.layoutID}
-	t := types.MakeTree(in.workspaceID)
-	if err := store.GetVersion(&j, t, in.jobID); err != nil {
+	return loadJob(store, in.workspaceID, in.layoutID, in.jobID)
+}
+
+func loadJob(store storage.Storer, wID, lID, jID string) (*types.Job, error) {
+	j, err := storage.GetJob(store, wID, lID, jID)
+	if err != nil {
 		return nil, errors.Wrap(err, "Cannot Load job")
 	}
-	return &j, nil
+
+	return j, nil
+}
+
+// saveJob saves the Job as a new version in its history.
+func saveJob(store storage.Storer, j *types.Job, in *input) error {
+	return highbrow.Try(5, func() error {
+		return storage.SaveJob(store, in.workspaceID, j)
+	})
+}
+
+// stopped tells if the Job was stopped from outside the worker, by an abort or by its Lock
+// being released by force, which marks it ABORTED or ERROR.
+func stopped(j *types.Job) bool {
+	return j.Status == types.JobAborted || j.Status == types.JobError
+}
+
+// startJob marks the Job RUNNING, along with the serial of the state it starts from.
+// Returns true if the Job was stopped before it could start.
+func startJob(store storage.Storer, in *input) (bool, error) {
+	j, err := getJob(store, in)
+	if err != nil {
+		return false, errors.Wrap(err, "Cannot get Job")
+	}
+
+	if stopped(j) {
+		return true, nil
+	}
+
+	state, err := store.GetKey(remotePath(in))
+	if err != nil {
+		return false, errors.Wrap(err, "Cannot get state")
+	}
+
+	serial, err := runner.StateSerial(state)
+	if err != nil {
+		return false, err
+	}
+
+	if err := snapshotState(store, in, types.StageBeforeJob, state); err != nil {
+		return false, err
+	}
+
+	j.Status = types.JobRunning
+	j.StartedAt = time.Now().UnixNano()
+	j.StateSerial = serial
+	return false, saveJob(store, j, in)
+}
+
+// snapshotState saves a version of the state of the Layout, unless the state is the
+// same as the latest version.
+func snapshotState(store storage.Storer, in *input, stage int32, state []byte) error {
+	if len(state) == 0 {
+		return nil
+	}
+
+	t := types.MakeTree(in.workspaceID, in.layoutID)
+
+	last := types.StateVersion{}
+	if err := store.Get(&last, t); err != nil {
+		if !strings.Contains(err.Error(), "Missing") {
+			return errors.Wrap(err, "Cannot get state version")
+		}
+	} else if bytes.Equal(last.State, state) {
+		return nil
+	}
+
+	serial, err := runner.StateSerial(state)
+	if err != nil {
+		return err
+	}
+
+	v := types.StateVersion{
+		JobId:     in.jobID,
+		Stage:     stage,
+		Serial:    serial,
+		State:     state,
+		CreatedAt: time.Now().UnixNano(),
+	}
+
+	return highbrow.Try(5, func() error {
+		return store.Save(&v, t)
+	})
+}
+
+// finishJob marks the Job DONE, or FAILED along with the error it exited with.
+// A Job that was stopped while running stays ABORTED or ERROR, in which case it returns true.
+func finishJob(store storage.Storer, in *input, jobErr error) (bool, error) {
+	j, err := getJob(store, in)
+	if err != nil {
+		return false, errors.Wrap(err, "Cannot get Job")
+	}
+
+	if stopped(j) {
+		return true, nil
+	}
+
+	j.Status = types.JobDone
+	j.Error = ""
+	if jobErr != nil {
+		j.Status = types.JobFailed
+		j.Error = jobErr.Error()
+	}
+
+	j.EndedAt = time.Now().UnixNano()
+	return false, saveJob(store, j, in)
 }
 
 // getLayout details
@@ -143,33 +278,107 @@ func getJobVars(store storage.Storer, j *types.Job, in *input) (*types.Vars, err
 	return &v, nil
 }
 
+// resolveSecrets referred to in the vars, in place. They are resolved only in memory, for
+// Terraform to use, and never saved back. Returns the values, for the runner to mask.
+func resolveSecrets(vars ...*types.Vars) ([]string, error) {
+	values := []string{}
+	for _, v := range vars {
+		_, found, err := secrets.Resolve(map[string]interface{}(*v))
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, found...)
+	}
+
+	return values, nil
+}
+
+// getOutputs of the Layouts, in the same Workspace, that the templates of a Layout refer to,
+// read from their state. Values of sensitive outputs are returned too, for the runner to mask.
+func getOutputs(store storage.Storer, l *types.Layout, in *input) (map[string]interface{}, []string, error) {
+	outputs := map[string]interface{}{}
+	sensitive := []string{}
+
+	ids := []string{}
+	for _, data := range l.Plan {
+		ids = append(ids, tmpl.OutputRefs(data)...)
+	}
+
+	// Layouts are looked up in order, so that the same one is reported if any lacks state.
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if _, ok := outputs[id]; ok {
+			continue
+		}
+
+		b, err := store.GetKey(path.Join(types.STATE, in.workspaceID, id))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "Cannot get state of Layout %v", id)
+		}
+
+		values, err := runner.StateOutputs(b)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "Cannot get outputs of Layout %v", id)
+		}
+
+		if values == nil {
+			return nil, nil, errors.Errorf("Layout %v has no applied state to refer to the outputs of", id)
+		}
+
+		o := map[string]interface{}{}
+		for k, v := range values {
+			o[k] = v.Value
+			if x, ok := v.Value.(string); ok && v.Sensitive && x != "" {
+				sensitive = append(sensitive, x)
+			}
+		}
+
+		outputs[id] = o
+	}
+
+	return outputs, sensitive, nil
+}
+
 func remotePath(in *input) string {
 	return path.Join("state", in.workspaceID, in.layoutID)
 }
 
-func getCmd(store storage.Storer, in *input) (*runner.Cmd, error) {
+// getCmd to run for the Job, which is returned along with it.
+func getCmd(store storage.Storer, in *input) (*runner.Cmd, *types.Job, error) {
 	j, err := getJob(store, in)
 	if err != nil {
-		return nil, errors.Wrap(err, "Cannot get Job")
+		return nil, nil, errors.Wrap(err, "Cannot get Job")
 	}
 
 	l, err := getLayout(store, j, in)
 	if err != nil {
-		return nil, errors.Wrap(err, "Cannot get Layout")
+		return nil, nil, errors.Wrap(err, "Cannot get Layout")
 	}
 
 	wv, err := getWorkspaceVars(store, in)
 	if err != nil {
-		return nil, errors.Wrap(err, "Cannot get workspace vars")
+		return nil, nil, errors.Wrap(err, "Cannot get workspace vars")
 	}
 
-	if err := padLayoutWithProvider(l.Plan, wv); err != nil {
-		return nil, errors.Wrap(err, "Cannot pad layout")
+	v, err := getJobVars(store, j, in)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Cannot get job vars")
 	}
 
-	v, err := getJobVars(store, j, in)
+	values, err := resolveSecrets(wv, v)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Cannot resolve secrets")
+	}
+
+	outputs, sensitive, err := getOutputs(store, l, in)
 	if err != nil {
-		return nil, errors.Wrap(err, "Cannot get job vars")
+		return nil, nil, errors.Wrap(err, "Cannot get outputs")
+	}
+
+	if err := padLayoutWithProvider(l.Plan, wv); err != nil {
+		return nil, nil, errors.Wrap(err, "Cannot pad layout")
 	}
 
 	op := j.Op
@@ -177,6 +386,10 @@ func getCmd(store storage.Storer, in *input) (*runner.Cmd, error) {
 		op = runner.PlanOp
 	}
 
+	if j.Drift {
+		op = runner.DriftOp
+	}
+
 	cmd := runner.Cmd{}
 	cmd.SetOp(op)
 	cmd.SetRemotePath(remotePath(in))
@@ -184,29 +397,186 @@ func getCmd(store storage.Storer, in *input) (*runner.Cmd, error) {
 	cmd.SetDir(path.Join("/tmp", in.tmpDir))
 	cmd.SetLayout(l.Plan)
 	cmd.SetVars(*v)
+	cmd.SetSecrets(append(values, sensitive...))
+	cmd.SetOutputs(outputs)
 	cmd.SetLogPrefix(j.Id)
-	return &cmd, nil
+	cmd.SetTargets(j.Targets)
+	cmd.SetReplace(j.Replace)
+	cmd.SetImport(j.ImportAddress, j.ImportId)
+
+	if j.PlanJobId != "" {
+		b, err := getPlanFile(store, j, in)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "Cannot get plan")
+		}
+
+		cmd.SetPlanFile(b)
+	}
+
+	return &cmd, j, nil
+}
+
+// getPlanFile saved by the dry Job that a Job is to apply.
+// Refuses if the state has changed since the plan was made, since it no longer holds.
+func getPlanFile(store storage.Storer, j *types.Job, in *input) ([]byte, error) {
+	pj, err := loadJob(store, in.workspaceID, in.layoutID, j.PlanJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if pj.StateSerial != j.StateSerial {
+		return nil, errors.Errorf(
+			"State has changed since Job %v made the plan, serial was %v and is now %v",
+			pj.Id, pj.StateSerial, j.StateSerial)
+	}
+
+	b, err := store.GetKey(pj.PlanFileKey(types.MakeTree(in.workspaceID)))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) == 0 {
+		return nil, errors.Errorf("No plan saved for Job %v", pj.Id)
+	}
+
+	return b, nil
 }
 
 // Engine tries to accept a storage and input and run the Command.
-func engine(store storage.Storer, in *input) (*url.URL, error) {
-	cmd, err := getCmd(store, in)
+// For a Job that checks for drift, also returns the result of the check.
+// A Layout that has drifted calls the failure callback of its watch.
+func engine(store storage.Storer, in *input) (*url.URL, *types.Drift, error) {
+	cmd, j, err := getCmd(store, in)
 	if err != nil {
-		return nil, errors.Wrap(err, "Cannot get cmd")
+		return nil, nil, errors.Wrap(err, "Cannot get cmd")
 	}
 
 	w, err := getLayoutWatch(store, in)
 	if err != nil {
-		return nil, errors.Wrap(err, "Cannot get layout watch")
+		return nil, nil, errors.Wrap(err, "Cannot get layout watch")
+	}
+
+	if in.serveState {
+		u, stop, err := serveState(store, in)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		defer stop()
+		cmd.SetBackendURL(u)
 	}
 
+	logs := newLogWriter(store, &types.Job{Id: in.jobID, LayoutId: in.layoutID}, in)
+	defer logs.Close()
+
+	cmd.SetLogWriter(logs)
+
+	stop := forwardSignals(cmd)
+	defer stop()
+
 	if err := cmd.Run(); err != nil {
 		u, _ := url.Parse(w.FailureURL)
-		return u, errors.Wrap(err, "Exited with failure")
+		return u, nil, errors.Wrap(err, "Exited with failure")
+	}
+
+	if err := savePlan(store, cmd, in); err != nil {
+		u, _ := url.Parse(w.FailureURL)
+		return u, nil, errors.Wrap(err, "Cannot save plan")
+	}
+
+	if !j.Drift {
+		u, _ := url.Parse(w.SuccessURL)
+		return u, nil, errors.Wrap(err, "Error executing Cmd")
+	}
+
+	d, err := saveDrift(store, cmd, in)
+	if err != nil {
+		u, _ := url.Parse(w.FailureURL)
+		return u, nil, errors.Wrap(err, "Cannot save drift")
 	}
 
 	u, _ := url.Parse(w.SuccessURL)
-	return u, errors.Wrap(err, "Error executing Cmd")
+	if d.Drifted {
+		u, _ = url.Parse(w.FailureURL)
+	}
+
+	return u, d, nil
+}
+
+// saveDrift records whether the Layout has drifted, and which of its resources have,
+// as the latest drift check of the Layout.
+func saveDrift(store storage.Storer, cmd *runner.Cmd, in *input) (*types.Drift, error) {
+	d := types.Drift{
+		JobId:     in.jobID,
+		Drifted:   cmd.Drifted(),
+		CheckedAt: time.Now().UnixNano(),
+	}
+
+	if d.Drifted {
+		p, err := runner.ParsePlan(cmd.Plan())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range p.Changes {
+			d.Resources = append(d.Resources, c.Address)
+		}
+	}
+
+	err := highbrow.Try(5, func() error {
+		return storage.SaveLatest(store, &d, types.MakeTree(in.workspaceID, in.layoutID))
+	})
+
+	return &d, err
+}
+
+// savePlan made by a dry Job, for it to be reviewed and then applied as is.
+func savePlan(store storage.Storer, cmd *runner.Cmd, in *input) error {
+	plan := cmd.Plan()
+	if plan == nil {
+		return nil
+	}
+
+	j := types.Job{Id: in.jobID, LayoutId: in.layoutID}
+	t := types.MakeTree(in.workspaceID)
+	return highbrow.Try(5, func() error {
+		// There is no Plan file to apply later if it held secrets.
+		if b := cmd.PlanFile(); len(b) > 0 {
+			if err := store.SaveKey(j.PlanFileKey(t), b); err != nil {
+				return err
+			}
+		}
+
+		return store.SaveKey(j.PlanKey(t), plan)
+	})
+}
+
+// forwardSignals passes an interrupt received by the worker, like the one Nomad sends
+// when a Job is aborted, on to Terraform so that it can stop gracefully.
+// Returns a func to stop forwarding.
+func forwardSignals(cmd *runner.Cmd) func() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case sig := <-ch:
+				log.Printf("Received %v, interrupting Terraform", sig)
+				if err := cmd.Interrupt(); err != nil {
+					log.Println(err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(ch)
+		close(done)
+	}
 }
 
 // MainRunner takes input parametes and does the rest.
@@ -215,17 +585,31 @@ func engine(store storage.Storer, in *input) (*url.URL, error) {
 func mainRunner(store storage.Storer, in *input, hook *url.URL) int {
 	status := 0
 
-	if err := func() error {
+	aborted, err := startJob(store, in)
+	if err != nil {
+		fmt.Printf("%+v\n", err)
+		return 127
+	}
+
+	if aborted {
+		log.Printf("Job %v was stopped, not running it.", in.jobID)
+		if err := unlockLayout(store, in); err != nil {
+			fmt.Printf("%+v\n", err)
+		}
+		return status
+	}
+
+	runErr := func() error {
 		startState, _ := store.GetKey(remotePath(in))
 
-		u, err := engine(store, in)
+		u, drift, err := engine(store, in)
 		if err != nil {
 			return errors.Wrap(err, "Cannot execute Engine.")
 		}
 
 		endState, _ := store.GetKey(remotePath(in))
 
-		body := &watchPacket{}
+		body := &watchPacket{Drift: drift}
 		if err := json.Unmarshal(startState, &body.OldState); err != nil {
 			log.Println(err)
 		}
@@ -234,6 +618,9 @@ func mainRunner(store storage.Storer, in *input, hook *url.URL) int {
 			log.Println(err)
 		}
 
+		body.OldState = in.redactor.Value(body.OldState)
+		body.NewState = in.redactor.Value(body.NewState)
+
 		bfinal, err := json.Marshal(body)
 		if err != nil {
 			return errors.Wrap(err, "Cannot marshal body to json.")
@@ -266,35 +653,106 @@ func mainRunner(store storage.Storer, in *input, hook *url.URL) int {
 		wg.Wait()
 
 		return nil
-	}(); err != nil {
-		fmt.Printf("%+v\n", err)
+	}()
+
+	if runErr != nil {
+		fmt.Printf("%+v\n", runErr)
 		status = 127
-	} else {
-		// UnLock Lock for workspace and layout.
-		key := fmt.Sprintf("%v-%v", *workspaceID, *layoutID)
-		highbrow.Try(5, func() error {
-			return store.Unlock(key)
-		})
+	}
+
+	// Saved even if the Job failed, a half done apply may have to be rolled back.
+	if endState, err := store.GetKey(remotePath(in)); err != nil {
+		fmt.Printf("%+v\n", err)
+	} else if err := snapshotState(store, in, types.StageAfterJob, endState); err != nil {
+		fmt.Printf("%+v\n", err)
+	}
+
+	aborted, err = finishJob(store, in, runErr)
+	if err != nil {
+		fmt.Printf("%+v\n", err)
+	}
+
+	if aborted {
+		log.Printf("Job %v was stopped.", in.jobID)
+	}
+
+	// Released whether the Job is DONE, FAILED, ABORTED or ERROR, once it is saved as such,
+	// for the next Job in the queue to be dispatched.
+	if err := unlockLayout(store, in); err != nil {
+		fmt.Printf("%+v\n", err)
 	}
 
 	return status
 }
 
+// unlockLayout releases the Lock of the Layout, if the Job still holds it.
+// An abort releases the Lock on its own, which may be held by another Job by now.
+func unlockLayout(store storage.Storer, in *input) error {
+	held, err := holdsLayout(store, in)
+	if err != nil || !held {
+		return err
+	}
+
+	return highbrow.Try(5, func() error {
+		return store.Unlock(layoutLockKey(in))
+	})
+}
+
+// holdsLayout tells if the Job holds the Lock of its Layout.
+func holdsLayout(store storage.Storer, in *input) (bool, error) {
+	holder, err := store.GetLock(layoutLockKey(in))
+	if err != nil {
+		return false, errors.Wrap(err, "Cannot get Lock")
+	}
+
+	return holder == in.jobID, nil
+}
+
+// layoutLockKey is the key the Layout of the Job is locked under, the same as the server's.
+func layoutLockKey(in *input) string {
+	return fmt.Sprintf("%v-%v", in.workspaceID, in.layoutID)
+}
+
 func main() {
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 
 	kingpin.Version(Version)
 	kingpin.Parse()
 
+	keys, err := envelope.LoadKeyring(*kekFile, *kek)
+	if err != nil {
+		log.Fatalf("Cannot load keys: %+v", err)
+	}
+
+	if *encryptState && keys == nil {
+		log.Fatal("Cannot encrypt state without keys, see --kek-file and --kek")
+	}
+
 	// Initialize Storage engine
-	store := consul.MakeConsulStore(*consulIP)
+	var store storage.Storer = consul.MakeConsulStore(*consulIP)
+	if keys != nil {
+		es := envelope.Wrap(store, keys)
+		if *encryptState {
+			es.EncryptState()
+		}
+		store = es
+	}
 	store.Setup()
 
+	secrets.Register("file", secrets.FileProvider{Dir: *secretsDir})
+
+	redactor, err := redact.New(*redactKeys, *redactValues)
+	if err != nil {
+		log.Fatalf("Cannot make redactor: %+v", err)
+	}
+
 	in := &input{
 		jobID:       *jobID,
 		workspaceID: *workspaceID,
 		layoutID:    *layoutID,
 		tmpDir:      *tmpDir,
+		serveState:  *encryptState,
+		redactor:    redactor,
 	}
 
 	os.Exit(mainRunner(store, in, *defaultHook))
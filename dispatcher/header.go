@@ -4,6 +4,7 @@ import "github.com/tsocial/tessellate/storage/types"
 
 type Dispatcher interface {
 	Dispatch(workspaceID string, job *types.Job) (string, error)
+	Abort(workspaceID string, job *types.Job) error
 }
 
 var instance Dispatcher
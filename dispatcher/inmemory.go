@@ -7,7 +7,8 @@ import (
 )
 
 type Mem struct {
-	Store []string
+	Store   []string
+	Aborted []string
 	sync.Mutex
 }
 
@@ -19,6 +20,14 @@ func (c *Mem) Dispatch(w string, j *types.Job) (string, error) {
 	return j.Id, nil
 }
 
+func (c *Mem) Abort(w string, j *types.Job) error {
+	c.Lock()
+	defer c.Unlock()
+
+	c.Aborted = append(c.Aborted, j.Id)
+	return nil
+}
+
 func NewInMemory() *Mem {
-	return &Mem{Store: []string{}}
+	return &Mem{Store: []string{}, Aborted: []string{}}
 }
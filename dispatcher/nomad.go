@@ -1,10 +1,12 @@
 package dispatcher
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/url"
 	"path"
+	"strings"
 
 	"github.com/flosch/pongo2"
 	"github.com/hashicorp/nomad/api"
@@ -12,7 +14,15 @@ import (
 	"github.com/tsocial/tessellate/tmpl"
 )
 
-const Papertrail = "Papertrail"
+const (
+	Papertrail = "Papertrail"
+
+	// Time given to the worker to interrupt Terraform before the task is killed.
+	killTimeout = "30s"
+
+	// Where the key file is mounted in the worker's container.
+	workerKeyFile = "/etc/tsl8/kek"
+)
 
 type client struct {
 	cfg NomadConfig
@@ -33,13 +43,45 @@ type NomadConfig struct {
 	CPU        string
 	Memory     string
 	ConsulAddr string
-	Log        *JobLog
+	// Key file on the Nomad clients, mounted into workers to decrypt vars with.
+	KeyFile string
+	// Have workers encrypt the state, see the worker's --encrypt-state.
+	EncryptState bool
+	// Rules workers redact logs and callbacks by, see the worker's --redact-key and --redact-value.
+	RedactKeys   []string
+	RedactValues []string
+	Log          *JobLog
 }
 
 func NewNomadClient(cfg NomadConfig) *client {
 	return &client{cfg}
 }
 
+// Name of the Nomad job that runs a Job of a Workspace.
+func jobName(w string, j *types.Job) string {
+	return w + "-" + j.LayoutId + "-" + j.Id
+}
+
+// hclString quotes s as a string in a job, where ${ would otherwise be interpolated.
+func hclString(s string) string {
+	b, _ := json.Marshal(s)
+	return strings.Replace(string(b), "${", "$${", -1)
+}
+
+// redactArgs passes the redaction rules on to workers, each argument quoted.
+func redactArgs(cfg NomadConfig) []string {
+	args := []string{}
+	for _, k := range cfg.RedactKeys {
+		args = append(args, hclString("--redact-key"), hclString(k))
+	}
+
+	for _, v := range cfg.RedactValues {
+		args = append(args, hclString("--redact-value"), hclString(v))
+	}
+
+	return args
+}
+
 func MakeNomadJob(w string, c *client, j *types.Job) (string, error) {
 	// Create a nomad job using go template
 	var tmplStr = `
@@ -57,9 +99,13 @@ job "{{ job_name }}" {
     task "apply_job" {
       driver = "docker"
 
+      kill_signal  = "SIGINT"
+      kill_timeout = "{{ kill_timeout }}"
+
       config {
         image = "{{ image }}"
-        entrypoint = ["./tsl8", "-j", "{{ job_id }}", "-w", "{{ workspace_id }}", "-l", "{{ layout_id }}", "--consul-host", "{{ consul_addr }}"]
+        entrypoint = ["./tsl8", "-j", "{{ job_id }}", "-w", "{{ workspace_id }}", "-l", "{{ layout_id }}", "--consul-host", "{{ consul_addr }}"{% if key_file %}, "--kek-file", "{{ worker_key_file }}"{% endif %}{% if encrypt_state %}, "--encrypt-state"{% endif %}{% for a in redact_args %}, {{ a|safe }}{% endfor %}]
+        {% if key_file %}volumes = ["{{ key_file }}:{{ worker_key_file }}:ro"]{% endif %}
 
 		logging {
 		  type = "syslog"
@@ -80,7 +126,7 @@ job "{{ job_name }}" {
 }
 `
 	cfg := pongo2.Context{
-		"job_name":        w + "-" + j.LayoutId + "-" + j.Id,
+		"job_name":        jobName(w, j),
 		"job_id":          j.Id,
 		"workspace_id":    w,
 		"layout_id":       j.LayoutId,
@@ -91,6 +137,11 @@ job "{{ job_name }}" {
 		"consul_addr":     c.cfg.ConsulAddr,
 		"attempts":        j.Retry,
 		"log_destination": c.cfg.Log.Destination,
+		"kill_timeout":    killTimeout,
+		"key_file":        c.cfg.KeyFile,
+		"worker_key_file": workerKeyFile,
+		"encrypt_state":   c.cfg.EncryptState,
+		"redact_args":     redactArgs(c.cfg),
 	}
 
 	if j.Dry {
@@ -110,17 +161,7 @@ func (c *client) Dispatch(w string, j *types.Job) (string, error) {
 
 	log.Println(nomadJob)
 
-	nConfig := api.DefaultConfig()
-	nConfig.Address = c.cfg.Address
-
-	if c.cfg.Username != "" {
-		nConfig.HttpAuth = &api.HttpBasicAuth{
-			Username: c.cfg.Username,
-			Password: c.cfg.Password,
-		}
-	}
-
-	cl, err := api.NewClient(nConfig)
+	cl, err := c.apiClient()
 	if err != nil {
 		log.Printf("error while creating nomad client: %+v", err)
 		return "", err
@@ -147,12 +188,12 @@ func (c *client) Dispatch(w string, j *types.Job) (string, error) {
 
 	var link string
 
-	u.Path = path.Join(u.Path, "ui", "jobs", w+"-"+j.LayoutId+"-"+j.Id)
+	u.Path = path.Join(u.Path, "ui", "jobs", jobName(w, j))
 	link = u.String()
 
 	if c.cfg.Log.Aggregator == Papertrail {
 		var logUrl *url.URL
-		jobFilter := fmt.Sprintf("tsl8w-%s-%s-%s", w, j.LayoutId, j.Id)
+		jobFilter := fmt.Sprintf("tsl8w-%s", jobName(w, j))
 
 		if logUrl, err = url.Parse(fmt.Sprintf("%s/events?q=program:%s", c.cfg.Log.PapertrailHost, jobFilter)); err == nil {
 			link = logUrl.String()
@@ -163,3 +204,37 @@ func (c *client) Dispatch(w string, j *types.Job) (string, error) {
 
 	return link, nil
 }
+
+// Abort stops the Nomad job running a Job.
+// Nomad sends the task's kill_signal to the worker, which passes it on to Terraform
+// so that it can stop gracefully.
+func (c *client) Abort(w string, j *types.Job) error {
+	cl, err := c.apiClient()
+	if err != nil {
+		log.Printf("error while creating nomad client: %+v", err)
+		return err
+	}
+
+	name := jobName(w, j)
+	if _, _, err := cl.Jobs().Deregister(name, false, nil); err != nil {
+		log.Printf("error while stopping nomad job %v: %+v", name, err)
+		return err
+	}
+
+	log.Printf("successfully stopped the job: %v", name)
+	return nil
+}
+
+func (c *client) apiClient() (*api.Client, error) {
+	nConfig := api.DefaultConfig()
+	nConfig.Address = c.cfg.Address
+
+	if c.cfg.Username != "" {
+		nConfig.HttpAuth = &api.HttpBasicAuth{
+			Username: c.cfg.Username,
+			Password: c.cfg.Password,
+		}
+	}
+
+	return api.NewClient(nConfig)
+}
@@ -1,13 +1,17 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 
 	"github.com/tsocial/tessellate/dispatcher"
+	"github.com/tsocial/tessellate/redact"
 	"github.com/tsocial/tessellate/server"
+	"github.com/tsocial/tessellate/storage"
 	"github.com/tsocial/tessellate/storage/consul"
+	"github.com/tsocial/tessellate/storage/envelope"
 	"google.golang.org/grpc/reflection"
 	"gopkg.in/alecthomas/kingpin.v2"
 )
@@ -33,6 +37,19 @@ var (
 	logDestination = kingpin.Flag("log-dest", "Logger aggregation destination address").Default("unix:///lib/systemd/system/syslog.socket").OverrideDefaultFromEnvar("LOG_DESTINATION").String()
 	logAggregator  = kingpin.Flag("log-agg", "Logger aggregation tool").Default("").OverrideDefaultFromEnvar("LOG_AGGREGATOR").String()
 	papertrailHost = kingpin.Flag("papertrail-host", "Papertrail Host").OverrideDefaultFromEnvar("PAPERTRAIL_HOST").String()
+	queueInterval  = kingpin.Flag("queue-interval", "Interval to look for queued jobs of released layouts").
+			Default("5s").Envar("QUEUE_INTERVAL").Duration()
+	kekFile = kingpin.Flag("kek-file", "File of base64 keys to encrypt vars with, one per line. The first one encrypts.").
+		Envar("TSL8_KEK_FILE").String()
+	kek           = kingpin.Flag("kek", "Comma separated base64 keys to encrypt vars with.").Envar("TSL8_KEK").String()
+	workerKekFile = kingpin.Flag("worker-kek-file", "Key file on the Nomad clients, mounted into workers.").
+			Envar("WORKER_KEK_FILE").String()
+	encryptState = kingpin.Flag("encrypt-state", "Encrypt the state of Layouts too, workers serve it to Terraform.").
+			Envar("TSL8_ENCRYPT_STATE").Bool()
+	redactKeys = kingpin.Flag("redact-key", "Pattern of keys whose values are secrets, matched anywhere in a key ignoring case. Repeat for more, replaces the defaults.").
+			Envar("TSL8_REDACT_KEYS").Strings()
+	redactValues = kingpin.Flag("redact-value", "Regex of values that are secrets wherever found, like PEM blocks. Repeat for more, replaces the defaults.").
+			Envar("TSL8_REDACT_VALUES").Strings()
 
 	unlocker = "tsl8_unlock_job"
 )
@@ -51,20 +68,45 @@ func main() {
 	s := server.Grpc()
 	defer s.GracefulStop()
 
+	keys, err := envelope.LoadKeyring(*kekFile, *kek)
+	if err != nil {
+		log.Fatalf("failed to load keys: %v", err)
+	}
+
+	if *encryptState && keys == nil {
+		log.Fatal("cannot encrypt state without keys, see --kek-file and --kek")
+	}
+
+	redactor, err := redact.New(*redactKeys, *redactValues)
+	if err != nil {
+		log.Fatalf("failed to make redactor: %v", err)
+	}
+
 	// Initialize Storage engine
-	store := consul.MakeConsulStore(*consulAddr)
+	var store storage.Storer = consul.MakeConsulStore(*consulAddr)
+	if keys != nil {
+		es := envelope.Wrap(store, keys)
+		if *encryptState {
+			es.EncryptState()
+		}
+		store = es
+	}
 	store.Setup()
 
 	// TODO: validate config first.
 	nomadClient := dispatcher.NewNomadClient(dispatcher.NomadConfig{
-		Address:    *nomadAddr,
-		Username:   *nomadHttpAuthUsername,
-		Password:   *nomadHttpAuthPassword,
-		Datacenter: *nomadDc,
-		Image:      *workerImage,
-		CPU:        *workerCPU,
-		Memory:     *workerMemory,
-		ConsulAddr: *consulAddr,
+		Address:      *nomadAddr,
+		Username:     *nomadHttpAuthUsername,
+		Password:     *nomadHttpAuthPassword,
+		Datacenter:   *nomadDc,
+		Image:        *workerImage,
+		CPU:          *workerCPU,
+		Memory:       *workerMemory,
+		ConsulAddr:   *consulAddr,
+		KeyFile:      *workerKekFile,
+		EncryptState: *encryptState,
+		RedactKeys:   *redactKeys,
+		RedactValues: *redactValues,
 		Log: &dispatcher.JobLog{
 			Destination:    *logDestination,
 			Aggregator:     *logAggregator,
@@ -77,7 +119,13 @@ func main() {
 	// check if a job exists with prefix name:
 	go nomadClient.GetOrSetCleanup(unlocker)
 
-	server.RegisterTessellateServer(s, server.New(store))
+	// Dispatch queued jobs as layouts are released.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go server.Schedule(ctx, store, *queueInterval)
+
+	server.RegisterTessellateServer(s, server.NewWithRedactor(store, redactor))
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
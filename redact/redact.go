@@ -0,0 +1,191 @@
+// Package redact masks secrets in vars, logs and state before they leave Tessellate.
+// What is a secret is told by rules: patterns of keys whose values are secrets, and
+// regexes of values that are secrets wherever they are found.
+package redact
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Mask that redacted values are replaced with.
+const Mask = "***********"
+
+// DefaultKeys whose values are redacted, unless others are given.
+var DefaultKeys = []string{
+	"secret", "access", "password", "passwd", "token", "private_key", "credential", "api_key",
+}
+
+// DefaultValues that are redacted wherever found, unless others are given.
+var DefaultValues = []string{
+	`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`,
+}
+
+var defaultRedactor = MustNew(nil, nil)
+
+// Beginning and end of a private key, in PEM, which spans many lines.
+var (
+	pemBegin = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)
+	pemEnd   = regexp.MustCompile(`-----END [A-Z ]*PRIVATE KEY-----`)
+)
+
+// Redactor masks values by the rules it was made with.
+// A nil Redactor masks by the default rules.
+type Redactor struct {
+	keys   []string
+	values []*regexp.Regexp
+
+	// Assignments to secret keys in text, like `password = "..."` or `"token": "..."`.
+	assign *regexp.Regexp
+}
+
+// New Redactor for values of keys that contain any of the patterns, ignoring case,
+// and for values that match any of the regexes.
+// The defaults are used for either if none are given.
+func New(keys, values []string) (*Redactor, error) {
+	if len(keys) == 0 {
+		keys = DefaultKeys
+	}
+
+	if len(values) == 0 {
+		values = DefaultValues
+	}
+
+	r := &Redactor{}
+	quoted := []string{}
+	for _, k := range keys {
+		k = strings.ToLower(strings.TrimSpace(k))
+		if k == "" {
+			continue
+		}
+
+		r.keys = append(r.keys, k)
+		quoted = append(quoted, regexp.QuoteMeta(k))
+	}
+
+	for _, v := range values {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid value pattern %v", v)
+		}
+
+		r.values = append(r.values, re)
+	}
+
+	if len(quoted) > 0 {
+		r.assign = regexp.MustCompile(`(?i)("?[\w.-]*(?:` + strings.Join(quoted, "|") +
+			`)[\w.-]*"?\s*[:=]\s*)"(?:[^"\\]|\\.)*"`)
+	}
+
+	return r, nil
+}
+
+// MustNew is New, that panics if a value pattern is invalid.
+func MustNew(keys, values []string) *Redactor {
+	r, err := New(keys, values)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Default Redactor, of the default rules.
+func Default() *Redactor {
+	return defaultRedactor
+}
+
+func (r *Redactor) rules() *Redactor {
+	if r == nil {
+		return defaultRedactor
+	}
+
+	return r
+}
+
+// Key tells if the values of a key are secrets.
+func (r *Redactor) Key(k string) bool {
+	k = strings.ToLower(k)
+	for _, s := range r.rules().keys {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String with the values that are secrets masked.
+func (r *Redactor) String(s string) string {
+	for _, re := range r.rules().values {
+		s = re.ReplaceAllLiteralString(s, Mask)
+	}
+
+	return s
+}
+
+// Text, like logs, with the values that are secrets and the values assigned to secret
+// keys masked.
+func (r *Redactor) Text(b []byte) []byte {
+	r = r.rules()
+	for _, re := range r.values {
+		b = re.ReplaceAllLiteral(b, []byte(Mask))
+	}
+
+	if r.assign != nil {
+		b = r.assign.ReplaceAll(b, []byte(`${1}"`+Mask+`"`))
+	}
+
+	return b
+}
+
+// Complete returns the length of the part of b that can be redacted on its own: up to
+// the end of its last line, short of the line where a private key begins if it does
+// not end in b. Text that is written in pieces, like logs, is to be held back past it,
+// so that a secret split across two pieces is still redacted.
+func Complete(b []byte) int {
+	n := bytes.LastIndexByte(b, '\n') + 1
+
+	begins := pemBegin.FindAllIndex(b[:n], -1)
+	if len(begins) == 0 {
+		return n
+	}
+
+	last := begins[len(begins)-1][0]
+	if pemEnd.Match(b[last:n]) {
+		return n
+	}
+
+	return bytes.LastIndexByte(b[:last], '\n') + 1
+}
+
+// Value, made of what JSON unmarshals to, redacted in place. Strings under secret keys,
+// at any depth, are masked as a whole.
+func (r *Redactor) Value(v interface{}) interface{} {
+	return r.rules().value(v, false)
+}
+
+func (r *Redactor) value(v interface{}, secret bool) interface{} {
+	switch x := v.(type) {
+	case string:
+		if secret {
+			return Mask
+		}
+		return r.String(x)
+
+	case map[string]interface{}:
+		for k := range x {
+			x[k] = r.value(x[k], secret || r.Key(k))
+		}
+
+	case []interface{}:
+		for i := range x {
+			x[i] = r.value(x[i], secret)
+		}
+	}
+
+	return v
+}
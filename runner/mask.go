@@ -0,0 +1,112 @@
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/tsocial/tessellate/redact"
+)
+
+// Size after which what a masker holds back is written anyway.
+const maskBufferSize = 64 * 1024
+
+// masker writes to w with every secret replaced by redact.Mask.
+// What is written is held back until a line, or a private key, is complete, so that a
+// secret split across two Writes is still replaced. Flush writes whatever is left.
+type masker struct {
+	w       io.Writer
+	secrets []string
+	buf     []byte
+}
+
+func (m *masker) Write(b []byte) (int, error) {
+	m.buf = append(m.buf, b...)
+
+	n := redact.Complete(m.buf)
+	if n == 0 && len(m.buf) >= maskBufferSize {
+		n = len(m.buf)
+	}
+
+	if err := m.write(n); err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// Flush writes what is held back.
+func (m *masker) Flush() error {
+	return m.write(len(m.buf))
+}
+
+// write the first n bytes held back, masked.
+func (m *masker) write(n int) error {
+	if n == 0 {
+		return nil
+	}
+
+	out := maskBytes(m.buf[:n], m.secrets)
+	m.buf = append(m.buf[:0], m.buf[n:]...)
+
+	_, err := m.w.Write(out)
+	return err
+}
+
+// maskBytes replaces each secret, as is and as escaped in a JSON string.
+func maskBytes(b []byte, secrets []string) []byte {
+	for _, s := range secrets {
+		b = bytes.Replace(b, []byte(s), []byte(redact.Mask), -1)
+
+		if e := jsonEscape(s); e != s {
+			b = bytes.Replace(b, []byte(e), []byte(redact.Mask), -1)
+		}
+	}
+
+	return b
+}
+
+func jsonEscape(s string) string {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return s
+	}
+
+	return string(b[1 : len(b)-1])
+}
+
+// maskJSON replaces the secrets in every string of a JSON document, leaving it valid.
+func maskJSON(b []byte, secrets []string) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(maskValue(v, secrets))
+}
+
+func maskValue(v interface{}, secrets []string) interface{} {
+	switch x := v.(type) {
+	case string:
+		for _, s := range secrets {
+			x = strings.Replace(x, s, redact.Mask, -1)
+		}
+		return x
+
+	case map[string]interface{}:
+		for k := range x {
+			x[k] = maskValue(x[k], secrets)
+		}
+
+	case []interface{}:
+		for i := range x {
+			x[i] = maskValue(x[i], secrets)
+		}
+	}
+
+	return v
+}
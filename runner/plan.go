@@ -0,0 +1,101 @@
+package runner
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Actions a Plan may take on a Resource.
+const (
+	CreateAction  = "create"
+	UpdateAction  = "update"
+	DeleteAction  = "delete"
+	ReplaceAction = "replace"
+)
+
+// Name of the file that terraform plan saves the Plan to.
+const planFile = "tfplan"
+
+// ResourceChange is what a Plan will do to a single Resource.
+type ResourceChange struct {
+	Address string
+	Type    string
+	Name    string
+	Action  string
+}
+
+// Plan summarises the output of terraform show -json, leaving out the Resources that
+// will not change.
+type Plan struct {
+	Changes []ResourceChange
+	Add     int
+	Change  int
+	Destroy int
+}
+
+type planJSON struct {
+	ResourceChanges []struct {
+		Address string `json:"address"`
+		Type    string `json:"type"`
+		Name    string `json:"name"`
+		Change  struct {
+			Actions []string `json:"actions"`
+		} `json:"change"`
+	} `json:"resource_changes"`
+}
+
+// ParsePlan reads the JSON representation of a Plan.
+// A Resource that is replaced counts as both added and destroyed, like terraform plan does.
+func ParsePlan(b []byte) (*Plan, error) {
+	var pj planJSON
+	if err := json.Unmarshal(b, &pj); err != nil {
+		return nil, errors.Wrap(err, "Cannot parse plan")
+	}
+
+	p := &Plan{Changes: []ResourceChange{}}
+	for _, rc := range pj.ResourceChanges {
+		action := planAction(rc.Change.Actions)
+		switch action {
+		case CreateAction:
+			p.Add++
+		case UpdateAction:
+			p.Change++
+		case DeleteAction:
+			p.Destroy++
+		case ReplaceAction:
+			p.Add++
+			p.Destroy++
+		default:
+			continue
+		}
+
+		p.Changes = append(p.Changes, ResourceChange{
+			Address: rc.Address,
+			Type:    rc.Type,
+			Name:    rc.Name,
+			Action:  action,
+		})
+	}
+
+	return p, nil
+}
+
+// planAction reduces the list of actions on a Resource to a single one.
+// Returns an empty string for no-op and read.
+func planAction(actions []string) string {
+	if len(actions) == 2 {
+		return ReplaceAction
+	}
+
+	if len(actions) != 1 {
+		return ""
+	}
+
+	switch actions[0] {
+	case CreateAction, UpdateAction, DeleteAction:
+		return actions[0]
+	}
+
+	return ""
+}
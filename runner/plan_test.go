@@ -0,0 +1,41 @@
+package runner
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePlan(t *testing.T) {
+	t.Run("Should summarise the changes of a plan", func(t *testing.T) {
+		b, err := ioutil.ReadFile("./testdata/plan.json")
+		assert.Nil(t, err)
+
+		p, err := ParsePlan(b)
+		assert.Nil(t, err)
+
+		assert.Equal(t, []ResourceChange{
+			{Address: "null_resource.sleep", Type: "null_resource", Name: "sleep", Action: CreateAction},
+			{Address: "aws_instance.web[0]", Type: "aws_instance", Name: "web", Action: UpdateAction},
+			{Address: "aws_instance.db", Type: "aws_instance", Name: "db", Action: ReplaceAction},
+			{Address: "aws_security_group.old", Type: "aws_security_group", Name: "old", Action: DeleteAction},
+		}, p.Changes)
+
+		assert.Equal(t, 2, p.Add)
+		assert.Equal(t, 1, p.Change)
+		assert.Equal(t, 2, p.Destroy)
+	})
+
+	t.Run("Should have no changes for an empty plan", func(t *testing.T) {
+		p, err := ParsePlan([]byte(`{"format_version": "0.1"}`))
+		assert.Nil(t, err)
+		assert.Empty(t, p.Changes)
+		assert.Equal(t, 0, p.Add+p.Change+p.Destroy)
+	})
+
+	t.Run("Should raise an error for invalid json", func(t *testing.T) {
+		_, err := ParsePlan([]byte("Plan: 1 to add"))
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,104 @@
+package runner
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// StateSerial reads the serial of a Terraform state.
+// Terraform bumps it on every change to the state, an empty state has serial 0.
+func StateSerial(b []byte) (int64, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	var s struct {
+		Serial int64 `json:"serial"`
+	}
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		return 0, errors.Wrap(err, "Cannot parse state")
+	}
+
+	return s.Serial, nil
+}
+
+// StateResources counts the resources in a Terraform state, of either format.
+// Terraform 0.12 onwards keeps a list of them, older versions a map per module.
+func StateResources(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	var s struct {
+		Resources []json.RawMessage `json:"resources"`
+		Modules   []struct {
+			Resources map[string]json.RawMessage `json:"resources"`
+		} `json:"modules"`
+	}
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		return 0, errors.Wrap(err, "Cannot parse state")
+	}
+
+	n := len(s.Resources)
+	for _, m := range s.Modules {
+		n += len(m.Resources)
+	}
+
+	return n, nil
+}
+
+type OutputValue struct {
+	Value     interface{} `json:"value"`
+	Sensitive bool        `json:"sensitive"`
+}
+
+type Output struct {
+	Path    []string               `json:"path"`
+	Outputs map[string]OutputValue `json:"outputs"`
+}
+
+// StateStruct reads the outputs of both the state formats. Terraform 0.12 onwards keeps
+// the outputs of the root module at the top level, older versions keep them per module.
+type StateStruct struct {
+	Modules []*Output              `json:"modules"`
+	Outputs map[string]OutputValue `json:"outputs"`
+}
+
+// StateOutputs returns the outputs in a state. Outputs of nested modules, which only
+// older versions keep, are named after the module like module.app.name.
+func StateOutputs(b []byte) (map[string]OutputValue, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	st := StateStruct{}
+	if err := json.Unmarshal(b, &st); err != nil {
+		return nil, errors.Wrap(err, "Cannot read state")
+	}
+
+	out := map[string]OutputValue{}
+	for k, v := range st.Outputs {
+		out[k] = v
+	}
+
+	for _, m := range st.Modules {
+		prefix := ""
+		for ix, p := range m.Path {
+			// Path of the root module is just root.
+			if ix == 0 && p == "root" {
+				continue
+			}
+
+			prefix += "module." + p + "."
+		}
+
+		for k, v := range m.Outputs {
+			out[prefix+k] = v
+		}
+	}
+
+	return out, nil
+}
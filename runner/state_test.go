@@ -0,0 +1,49 @@
+package runner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateSerial(t *testing.T) {
+	t.Run("Should read the serial of a state", func(t *testing.T) {
+		s, err := StateSerial([]byte(`{"version": 4, "serial": 12, "lineage": "x"}`))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(12), s)
+	})
+
+	t.Run("Empty state has serial 0", func(t *testing.T) {
+		s, err := StateSerial([]byte{})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), s)
+	})
+
+	t.Run("Should raise an error for an invalid state", func(t *testing.T) {
+		_, err := StateSerial([]byte("not a state"))
+		assert.NotNil(t, err)
+	})
+}
+
+func TestStateResources(t *testing.T) {
+	t.Run("Should count the resources of a state", func(t *testing.T) {
+		n, err := StateResources([]byte(`{"version": 4, "resources": [{"type": "null_resource"}, {"type": "aws_instance"}]}`))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("Should count the resources of every module of an older state", func(t *testing.T) {
+		n, err := StateResources([]byte(`{"version": 3, "modules": [
+			{"path": ["root"], "resources": {"null_resource.a": {}}},
+			{"path": ["root", "db"], "resources": {"aws_instance.b": {}, "aws_instance.c": {}}}
+		]}`))
+		assert.Nil(t, err)
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("Empty state has no resources", func(t *testing.T) {
+		n, err := StateResources([]byte{})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+	})
+}
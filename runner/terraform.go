@@ -10,22 +10,34 @@ import (
 
 	"io"
 	"strings"
+	"sync"
+	"syscall"
 
 	"github.com/flosch/pongo2"
 	"github.com/pkg/errors"
 	"github.com/tsocial/tessellate/tmpl"
 )
 
+// Ops up to ImportOp are the Operations a Job is made for, the rest are internal.
 const (
 	ApplyOp   = 0
 	DestroyOp = 1
-	PlanOp    = 2
+	RefreshOp = 2
+	ImportOp  = 3
+	PlanOp    = 4
+	DriftOp   = 5
 )
 
+// Exit code of a plan with -detailed-exitcode, when there are changes to make.
+const driftExitCode = 2
+
 var opMap = map[int32][]string{
-	PlanOp:    {"plan"},
+	PlanOp:    {"plan", "-out=" + planFile},
+	DriftOp:   {"plan", "-detailed-exitcode", "-out=" + planFile},
 	ApplyOp:   {"apply", "-auto-approve"},
 	DestroyOp: {"destroy", "-auto-approve"},
+	RefreshOp: {"apply", "-refresh-only", "-auto-approve"},
+	ImportOp:  {"import"},
 }
 
 func remoteLayout(addr, path string) map[string]interface{} {
@@ -42,6 +54,23 @@ func remoteLayout(addr, path string) map[string]interface{} {
 	}
 }
 
+// httpRemoteLayout keeps the state behind an HTTP backend, which Tessellate serves.
+// Locks are taken at the same address, which only grants them to the Job that holds
+// the Layout.
+func httpRemoteLayout(address string) map[string]interface{} {
+	return map[string]interface{}{
+		"terraform": map[string]interface{}{
+			"backend": map[string]interface{}{
+				"http": map[string]interface{}{
+					"address":        address,
+					"lock_address":   address,
+					"unlock_address": address,
+				},
+			},
+		},
+	}
+}
+
 func tmplVars(m interface{}) (map[string]pongo2.Context, error) {
 	if m == nil {
 		return nil, nil
@@ -68,15 +97,36 @@ func makeDir(dir string) error {
 type Cmd struct {
 	key        string
 	skipInit   bool
+	opCode     int32
 	op         []string
 	layout     map[string]json.RawMessage
 	vars       map[string]interface{}
 	stdout     io.Writer
 	stderr     io.WriteCloser
+	logs       io.Writer
 	dir        string
 	logPrefix  string
 	remoteAddr string
 	remotePath string
+	backendURL string
+	plan       []byte
+	planFile   []byte
+	savedPlan  []byte
+	drifted    bool
+	targets    []string
+	replace    []string
+	importArgs []string
+	secrets    []string
+	outputs    map[string]interface{}
+
+	mu          sync.Mutex
+	process     *os.Process
+	interrupted bool
+}
+
+type writeCloser struct {
+	io.Writer
+	io.Closer
 }
 
 // - Prepares the Basic Directories.
@@ -99,6 +149,21 @@ func (p *Cmd) Run() error {
 	p.stderr = os.Stderr
 	defer p.stderr.Close()
 
+	if p.logs != nil {
+		p.stdout = io.MultiWriter(os.Stdout, p.logs)
+		p.stderr = writeCloser{io.MultiWriter(os.Stderr, p.logs), os.Stderr}
+	}
+
+	if len(p.secrets) > 0 {
+		stdout := &masker{w: p.stdout, secrets: p.secrets}
+		stderr := &masker{w: p.stderr, secrets: p.secrets}
+		defer stdout.Flush()
+		defer stderr.Flush()
+
+		p.stdout = stdout
+		p.stderr = writeCloser{stderr, p.stderr}
+	}
+
 	if err := p.saveLayout(); err != nil {
 		return errors.Wrap(err, "Cannot save Layout")
 	}
@@ -107,8 +172,12 @@ func (p *Cmd) Run() error {
 		return errors.Wrap(err, "Cannot save vars")
 	}
 
-	if p.remoteAddr != "" && p.remotePath != "" {
-		if err := p.saveRemote(p.remoteAddr); err != nil {
+	if p.backendURL != "" {
+		if err := p.saveRemote(httpRemoteLayout(p.backendURL)); err != nil {
+			return errors.Wrap(err, "Cannot save Remote")
+		}
+	} else if p.remoteAddr != "" && p.remotePath != "" {
+		if err := p.saveRemote(remoteLayout(p.remoteAddr, p.remotePath)); err != nil {
 			return errors.Wrap(err, "Cannot save Remote")
 		}
 	}
@@ -117,16 +186,154 @@ func (p *Cmd) Run() error {
 		return errors.Wrap(err, "Cannot init Layout")
 	}
 
+	if p.savedPlan != nil {
+		if err := p.writePlanFile(); err != nil {
+			return errors.Wrap(err, "Cannot save Plan file")
+		}
+	}
+
 	c := p.getCmd()
 	p.stdout.Write([]byte(fmt.Sprintf("Executing Command %+v", c)))
 
-	if err := c.Run(); err != nil {
+	if err := p.start(c); err != nil {
 		return errors.Wrap(err, "Error executing Command")
 	}
 
+	defer p.setProcess(nil)
+
+	if err := c.Wait(); err != nil {
+		if !p.isDrift(err) {
+			return errors.Wrap(err, "Error executing Command")
+		}
+
+		p.drifted = true
+	}
+
+	if p.opCode == PlanOp || p.opCode == DriftOp {
+		if err := p.showPlan(); err != nil {
+			return errors.Wrap(err, "Cannot show Plan")
+		}
+	}
+
 	return nil
 }
 
+// A DriftOp exits with driftExitCode when the infrastructure differs from the Layout.
+func (p *Cmd) isDrift(err error) bool {
+	if p.opCode != DriftOp {
+		return false
+	}
+
+	e, ok := err.(*exec.ExitError)
+	if !ok {
+		return false
+	}
+
+	s, ok := e.Sys().(syscall.WaitStatus)
+	return ok && s.ExitStatus() == driftExitCode
+}
+
+// Drifted is true if a DriftOp found changes to make.
+func (p *Cmd) Drifted() bool {
+	return p.drifted
+}
+
+// Plan returns the JSON representation of the Plan made by a PlanOp.
+// Returns nil for other ops, or if the Cmd is yet to Run.
+func (p *Cmd) Plan() []byte {
+	return p.plan
+}
+
+// PlanFile returns the Plan made by a PlanOp, in the binary form that can be applied.
+// Returns nil for other ops, or if the Cmd is yet to Run.
+func (p *Cmd) PlanFile() []byte {
+	return p.planFile
+}
+
+// SetPlanFile to apply, made by an earlier PlanOp.
+// Terraform then applies exactly what was planned, instead of making a new plan.
+func (p *Cmd) SetPlanFile(b []byte) {
+	p.savedPlan = b
+}
+
+func (p *Cmd) writePlanFile() error {
+	lPath := fmt.Sprintf("%v/%v", p.dir, planFile)
+	p.stdout.Write([]byte("Saving Plan file\n"))
+	return ioutil.WriteFile(lPath, p.savedPlan, os.ModePerm)
+}
+
+// Reads the saved Plan file, both as is and as JSON.
+func (p *Cmd) showPlan() error {
+	b, err := ioutil.ReadFile(fmt.Sprintf("%v/%v", p.dir, planFile))
+	if err != nil {
+		return errors.Wrap(err, "Cannot read Plan file")
+	}
+
+	// A Plan file holds the values of vars, which must not leave the worker if any were secrets.
+	// Such a Plan cannot be applied later, but it can still be reviewed.
+	if len(p.secrets) == 0 {
+		p.planFile = b
+	}
+
+	c := exec.Command(TerraformPath(), "show", "-json", "-no-color", planFile)
+	c.Stderr = p.stderr
+	c.Dir = p.dir
+
+	log.Printf("Executing %+v", c)
+	out, err := c.Output()
+	if err != nil {
+		return errors.Wrap(err, "Error executing show")
+	}
+
+	if len(p.secrets) > 0 {
+		if out, err = maskJSON(out, p.secrets); err != nil {
+			return errors.Wrap(err, "Cannot mask Plan")
+		}
+	}
+
+	p.plan = out
+	return nil
+}
+
+// Interrupt asks a running Terraform command to stop gracefully, which lets it
+// release the state lock on its way out.
+// If the command is yet to start, it never will.
+func (p *Cmd) Interrupt() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.interrupted = true
+	if p.process == nil {
+		return nil
+	}
+
+	return p.process.Signal(os.Interrupt)
+}
+
+// Start the command, unless it was interrupted already.
+func (p *Cmd) start(c *exec.Cmd) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.interrupted {
+		return errors.New("Interrupted")
+	}
+
+	if err := c.Start(); err != nil {
+		return err
+	}
+
+	p.process = c.Process
+	return nil
+}
+
+func (p *Cmd) setProcess(proc *os.Process) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.process = proc
+}
+
 func (p *Cmd) SetRemotePath(path string) {
 	p.remotePath = path
 }
@@ -135,9 +342,11 @@ func (p *Cmd) SetRemotePath(path string) {
 func (p *Cmd) SetOp(op int32) {
 	o, ok := opMap[op]
 	if !ok {
-		o = opMap[ApplyOp]
+		op = ApplyOp
+		o = opMap[op]
 	}
 
+	p.opCode = op
 	p.op = o
 }
 
@@ -155,15 +364,51 @@ func (p *Cmd) SetRemote(addr string) {
 	p.remoteAddr = addr
 }
 
+// SetBackendURL of an HTTP backend to keep the state with, instead of Consul.
+func (p *Cmd) SetBackendURL(u string) {
+	p.backendURL = u
+}
+
 // SetVars is vars Setter.
 func (p *Cmd) SetVars(v map[string]interface{}) {
 	p.vars = v
 }
 
+// SetSecrets that were resolved into the vars, to be masked in whatever the Cmd writes
+// or returns.
+func (p *Cmd) SetSecrets(values []string) {
+	p.secrets = values
+}
+
+// SetOutputs of other Layouts, by their ID, that the Layout's templates refer to.
+func (p *Cmd) SetOutputs(outputs map[string]interface{}) {
+	p.outputs = outputs
+}
+
 func (p *Cmd) SetLogPrefix(prefix string) {
 	p.logPrefix = prefix
 }
 
+// SetTargets limits the op to the given resource addresses.
+func (p *Cmd) SetTargets(addrs []string) {
+	p.targets = addrs
+}
+
+// SetReplace forces the resources at the given addresses to be destroyed and created again.
+func (p *Cmd) SetReplace(addrs []string) {
+	p.replace = addrs
+}
+
+// SetImport of the resource with the given provider ID, to the address in the Layout.
+func (p *Cmd) SetImport(addr, id string) {
+	p.importArgs = []string{addr, id}
+}
+
+// SetLogWriter to receive a copy of everything Terraform writes to stdout and stderr.
+func (p *Cmd) SetLogWriter(w io.Writer) {
+	p.logs = w
+}
+
 func (p *Cmd) ClearDir(path string) error {
 	if p.dir != "" {
 		return os.RemoveAll(path)
@@ -173,10 +418,10 @@ func (p *Cmd) ClearDir(path string) error {
 }
 
 // Save the remote layout in a directory called .terraform.
-func (p *Cmd) saveRemote(addr string) error {
+func (p *Cmd) saveRemote(remote map[string]interface{}) error {
 	lPath := fmt.Sprintf("%v/state.tf.json", p.dir)
 	p.stdout.Write([]byte("Saving Remote state file\n"))
-	lData, err := json.Marshal(remoteLayout(addr, p.remotePath))
+	lData, err := json.Marshal(remote)
 	if err != nil {
 		return errors.Wrap(err, "Cannot Marshal remote State")
 	}
@@ -198,7 +443,16 @@ func (p *Cmd) saveLayout() error {
 
 	fn := func(data json.RawMessage, name string) error {
 		p.stdout.Write([]byte(fmt.Sprintf("Saving Layout %v", name)))
-		layout, err := tmpl.ParseLayout(data, tv[name])
+
+		ctx := tv[name]
+		if p.outputs != nil {
+			if ctx == nil {
+				ctx = pongo2.Context{}
+			}
+			ctx[tmpl.OutputsVar] = p.outputs
+		}
+
+		layout, err := tmpl.ParseLayout(data, ctx)
 		if err != nil {
 			return errors.Wrap(err, "Invalid Layout template")
 		}
@@ -258,6 +512,25 @@ func (p *Cmd) saveVars() error {
 
 func (p *Cmd) getCmd() *exec.Cmd {
 	op := append(p.op, "-no-color")
+
+	for _, t := range p.targets {
+		op = append(op, "-target="+t)
+	}
+
+	for _, r := range p.replace {
+		op = append(op, "-replace="+r)
+	}
+
+	// Address and ID must be the last arguments.
+	if p.opCode == ImportOp {
+		op = append(op, p.importArgs...)
+	}
+
+	// Plan file must be the last argument.
+	if p.savedPlan != nil {
+		op = append(op, planFile)
+	}
+
 	cmd := exec.Command(TerraformPath(), op...)
 	cmd.Stdout = p.stdout
 	cmd.Stderr = p.stderr
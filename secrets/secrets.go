@@ -0,0 +1,165 @@
+// Package secrets resolves references to secrets, found in vars, to their values.
+// A reference looks like secret://<kind>/<name>, where the kind picks the SecretProvider
+// that looks the name up.
+package secrets
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const Scheme = "secret://"
+
+// DefaultDir that file secrets are read from.
+const DefaultDir = "/etc/tsl8/secrets"
+
+// SecretProvider looks up the value of a secret by its name.
+type SecretProvider interface {
+	Secret(name string) (string, error)
+}
+
+var (
+	mu        sync.RWMutex
+	providers = map[string]SecretProvider{
+		"file": FileProvider{Dir: DefaultDir},
+		"env":  EnvProvider{},
+	}
+)
+
+// Register a SecretProvider for the references of a kind, replacing any there was.
+func Register(kind string, p SecretProvider) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	providers[kind] = p
+}
+
+func provider(kind string) (SecretProvider, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	p, ok := providers[kind]
+	return p, ok
+}
+
+// IsRef tells if a value is a reference to a secret.
+func IsRef(v string) bool {
+	return strings.HasPrefix(v, Scheme)
+}
+
+// Lookup the value of a reference.
+func Lookup(ref string) (string, error) {
+	kind, name := "", ""
+	if parts := strings.SplitN(strings.TrimPrefix(ref, Scheme), "/", 2); len(parts) == 2 {
+		kind, name = parts[0], parts[1]
+	}
+
+	if kind == "" || name == "" {
+		return "", errors.Errorf("Invalid secret reference %v, expected %v<kind>/<name>", ref, Scheme)
+	}
+
+	p, ok := provider(kind)
+	if !ok {
+		return "", errors.Errorf("No provider for secrets of kind %v", kind)
+	}
+
+	v, err := p.Secret(name)
+	if err != nil {
+		return "", errors.Wrapf(err, "Cannot resolve %v", ref)
+	}
+
+	return v, nil
+}
+
+// Resolve the references found anywhere in v, which is made of what JSON unmarshals to.
+// Maps and lists are resolved in place. Returns v, along with the values resolved, so
+// that they can be kept out of whatever is logged or saved.
+func Resolve(v interface{}) (interface{}, []string, error) {
+	found := []string{}
+	out, err := resolve(v, &found)
+	return out, found, err
+}
+
+func resolve(v interface{}, found *[]string) (interface{}, error) {
+	switch x := v.(type) {
+	case string:
+		if !IsRef(x) {
+			return x, nil
+		}
+
+		s, err := Lookup(x)
+		if err != nil {
+			return nil, err
+		}
+
+		if s != "" {
+			*found = append(*found, s)
+		}
+		return s, nil
+
+	case map[string]interface{}:
+		for k := range x {
+			r, err := resolve(x[k], found)
+			if err != nil {
+				return nil, err
+			}
+			x[k] = r
+		}
+
+	case []interface{}:
+		for i := range x {
+			r, err := resolve(x[i], found)
+			if err != nil {
+				return nil, err
+			}
+			x[i] = r
+		}
+	}
+
+	return v, nil
+}
+
+// FileProvider reads secrets from the files in a directory, one secret per file.
+// A trailing newline is not part of the secret.
+type FileProvider struct {
+	Dir string
+}
+
+func (f FileProvider) Secret(name string) (string, error) {
+	if name != filepath.Base(name) || name == "." || name == ".." {
+		return "", errors.Errorf("Secret file %v must be a name in %v", name, f.Dir)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(f.Dir, name))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+// EnvPrefix of the variables in the environment of the worker that are secrets.
+const EnvPrefix = "TSL8_SECRET_"
+
+// EnvProvider reads secrets from the environment of the worker. Only variables named
+// with the EnvPrefix are secrets, and are referred to by the rest of their name, so that
+// vars cannot read the worker's own settings and credentials, like CONSUL_HTTP_TOKEN.
+type EnvProvider struct{}
+
+func (EnvProvider) Secret(name string) (string, error) {
+	if name == "" {
+		return "", errors.New("Secret needs a name")
+	}
+
+	v, ok := os.LookupEnv(EnvPrefix + name)
+	if !ok {
+		return "", errors.Errorf("%v%v is not set", EnvPrefix, name)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,72 @@
+package secrets
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type staticProvider map[string]string
+
+func (s staticProvider) Secret(name string) (string, error) {
+	return s[name], nil
+}
+
+func TestResolve(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "aws_secret_key"), []byte("s3cr3t\n"), 0600))
+	Register("file", FileProvider{Dir: dir})
+	defer Register("file", FileProvider{Dir: DefaultDir})
+
+	os.Setenv(EnvPrefix+"DB_PASSWORD", "pa55")
+	defer os.Unsetenv(EnvPrefix + "DB_PASSWORD")
+
+	os.Setenv("CONSUL_HTTP_TOKEN", "t0k3n")
+	defer os.Unsetenv("CONSUL_HTTP_TOKEN")
+
+	t.Run("Should resolve references anywhere in the vars", func(t *testing.T) {
+		vars := map[string]interface{}{
+			"region": "ap-south-1",
+			"aws":    map[string]interface{}{"secret_key": "secret://file/aws_secret_key"},
+			"dbs":    []interface{}{map[string]interface{}{"password": "secret://env/DB_PASSWORD"}},
+		}
+
+		_, values, err := Resolve(vars)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{"s3cr3t", "pa55"}, values)
+		assert.Equal(t, "ap-south-1", vars["region"])
+		assert.Equal(t, "s3cr3t", vars["aws"].(map[string]interface{})["secret_key"])
+		assert.Equal(t, "pa55", vars["dbs"].([]interface{})[0].(map[string]interface{})["password"])
+	})
+
+	t.Run("Should resolve with a registered provider", func(t *testing.T) {
+		Register("static", staticProvider{"token": "t0k3n"})
+
+		v, values, err := Resolve("secret://static/token")
+		assert.Nil(t, err)
+		assert.Equal(t, "t0k3n", v)
+		assert.Equal(t, []string{"t0k3n"}, values)
+	})
+
+	t.Run("Should not resolve bad references", func(t *testing.T) {
+		for _, ref := range []string{
+			"secret://file",
+			"secret://vault/token",
+			"secret://file/missing",
+			"secret://file/../aws_secret_key",
+			"secret://env/UNSET_SECRET",
+			"secret://env/TSL8_KEK",
+			"secret://env/CONSUL_HTTP_TOKEN",
+			"secret://env/" + EnvPrefix + "DB_PASSWORD",
+		} {
+			_, _, err := Resolve(map[string]interface{}{"key": ref})
+			assert.NotNil(t, err, ref)
+		}
+	})
+}
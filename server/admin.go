@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage/types"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// caller of a request, the Common Name of the verified client certificate it was made with.
+// Empty if the request was not made over TLS.
+func caller(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return ""
+	}
+
+	for _, chain := range info.State.VerifiedChains {
+		if len(chain) > 0 {
+			return chain[0].Subject.CommonName
+		}
+	}
+
+	return ""
+}
+
+// requireAdmin returns the caller of a request, if it is one of the admins.
+func requireAdmin(ctx context.Context) (string, error) {
+	c := caller(ctx)
+	if c == "" {
+		return "", errors.Errorf("%v: Admin operations need a client certificate", Errors_NOT_ALLOWED)
+	}
+
+	for _, a := range *admins {
+		if a == c {
+			return c, nil
+		}
+	}
+
+	return "", errors.Errorf("%v: %v is not an admin", Errors_NOT_ALLOWED, c)
+}
+
+// audit an admin operation on a Workspace, or a change to its vars.
+// The operation has already been performed, so failing to record it is only logged.
+func (s *Server) audit(wID string, e *types.AuditEvent) {
+	e.CreatedAt = time.Now().UnixNano()
+	log.Printf("Audit %v: %+v", wID, e)
+
+	if err := s.store.Save(e, types.MakeTree(wID)); err != nil {
+		log.Printf("Cannot save audit event of %v: %+v", wID, err)
+	}
+}
+
+// ListAuditEvents of a Workspace, newest first. Allowed to admins only.
+func (s *Server) ListAuditEvents(ctx context.Context, in *GetWorkspaceRequest) (*AuditEvents, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	tree := types.MakeTree(in.Id)
+	ids, err := s.store.GetVersions(&types.AuditEvent{}, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AuditEvents{Events: []*AuditEvent{}}
+	for _, id := range newestFirst(ids) {
+		e := types.AuditEvent{}
+		if err := s.store.GetVersion(&e, tree, id); err != nil {
+			return nil, err
+		}
+
+		out.Events = append(out.Events, &AuditEvent{
+			Id:          id,
+			Action:      e.Action,
+			Actor:       e.Actor,
+			WorkspaceId: in.Id,
+			LayoutId:    e.LayoutId,
+			Detail:      e.Detail,
+			CreatedAt:   e.CreatedAt,
+		})
+	}
+
+	return out, nil
+}
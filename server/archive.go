@@ -0,0 +1,169 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage/archive"
+	"github.com/tsocial/tessellate/storage/envelope"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// Size of the chunks that an archive is sent in.
+const archiveChunkSize = 64 * 1024
+
+// chunkSender sends whatever is written to it as ArchiveChunks.
+type chunkSender struct {
+	stream Tessellate_ExportWorkspaceServer
+}
+
+func (c *chunkSender) Write(b []byte) (int, error) {
+	// The stream may hold on to the message, so it gets a copy.
+	data := append([]byte{}, b...)
+	if err := c.stream.Send(&ArchiveChunk{Data: data}); err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// ExportWorkspace streams a Workspace as a tar.gz. Vars and state are encrypted with the
+// Key of the request, or redacted without one.
+func (s *Server) ExportWorkspace(in *ExportWorkspaceRequest, stream Tessellate_ExportWorkspaceServer) error {
+	if err := in.Validate(); err != nil {
+		return errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	admin, err := requireAdmin(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	w := types.Workspace(in.Id)
+	if err := s.store.Get(&w, types.MakeTree(in.Id)); err != nil {
+		return errors.Wrap(err, Errors_NOT_FOUND.String())
+	}
+
+	var keys *envelope.Keyring
+	if in.Key != "" {
+		if keys, err = envelope.NewKeyring(in.Key); err != nil {
+			return errors.Wrap(err, Errors_INVALID_VALUE.String())
+		}
+	}
+
+	bw := bufio.NewWriterSize(&chunkSender{stream: stream}, archiveChunkSize)
+	if err := archive.Export(s.store, in.Id, bw, keys, s.redactor); err != nil {
+		return errors.Wrap(err, "Cannot export workspace")
+	}
+
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+
+	s.audit(in.Id, &types.AuditEvent{
+		Action: "ExportWorkspace",
+		Actor:  admin,
+		Detail: fmt.Sprintf("Encrypted: %v", keys != nil),
+	})
+
+	return nil
+}
+
+// chunkReader reads the archive out of the ImportWorkspaceRequests of a stream.
+type chunkReader struct {
+	stream Tessellate_ImportWorkspaceServer
+	buf    []byte
+}
+
+func (c *chunkReader) Read(b []byte) (int, error) {
+	for len(c.buf) == 0 {
+		in, err := c.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		c.buf = in.Data
+	}
+
+	n := copy(b, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+// ImportWorkspace out of an archive made by ExportWorkspace, with its vars and state
+// encrypted. The Workspace must not exist already.
+func (s *Server) ImportWorkspace(stream Tessellate_ImportWorkspaceServer) error {
+	admin, err := requireAdmin(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	if err := first.Validate(); err != nil {
+		return errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	if first.Key == "" {
+		return errors.Errorf("%v: Key is needed to import a Workspace", Errors_INVALID_VALUE)
+	}
+
+	keys, err := envelope.NewKeyring(first.Key)
+	if err != nil {
+		return errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	ar, err := archive.NewReader(&chunkReader{stream: stream, buf: first.Data})
+	if err != nil {
+		return errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	if !ar.Manifest.Encrypted {
+		return errors.Errorf("%v: Archive has its secrets redacted, export it with a Key to import it",
+			Errors_NOT_ALLOWED)
+	}
+
+	wID := first.WorkspaceId
+	if wID == "" {
+		wID = ar.Manifest.Workspace
+	}
+
+	if wID == "" || strings.Contains(wID, "/") {
+		return errors.Errorf("%v: Invalid Workspace name %q", Errors_INVALID_VALUE, wID)
+	}
+
+	w := types.Workspace(wID)
+	err = s.store.Get(&w, types.MakeTree(wID))
+	if err == nil {
+		return errors.Errorf("%v: Workspace %v already exists", Errors_NOT_ALLOWED, wID)
+	}
+
+	if !strings.Contains(err.Error(), "Missing") {
+		return err
+	}
+
+	n, err := ar.Import(s.store, wID, keys)
+	if err != nil {
+		return errors.Wrap(err, "Cannot import workspace")
+	}
+
+	s.audit(wID, &types.AuditEvent{
+		Action: "ImportWorkspace",
+		Actor:  admin,
+		Detail: fmt.Sprintf("Imported %d keys of %v", n, ar.Manifest.Workspace),
+	})
+
+	// The rest of the stream, if any, is not part of the archive.
+	if _, err := io.Copy(ioutil.Discard, &chunkReader{stream: stream}); err != nil && err != io.EOF {
+		return err
+	}
+
+	return stream.SendAndClose(&Ok{})
+}
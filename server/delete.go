@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/meson10/highbrow"
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/runner"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// DeleteLayout along with its vars, watch, jobs and state, holding its Lock meanwhile.
+// Refused while the Layout is busy, or while its state still has resources, unless
+// the Layout was destroyed since or Force is set.
+func (s *Server) DeleteLayout(ctx context.Context, in *DeleteLayoutRequest) (*Ok, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	wID, lID := in.WorkspaceId, in.Id
+
+	layout := types.Layout{Id: lID}
+	if err := s.store.Get(&layout, types.MakeTree(wID)); err != nil {
+		return nil, errors.Wrap(err, Errors_NOT_FOUND.String())
+	}
+
+	// Held till the Layout is gone, so that no Job starts on it in the meantime.
+	key := lockKey(wID, lID)
+	if err := s.lock(wID, lID, "delete-"+lID); err != nil {
+		holder, _ := s.store.GetLock(key)
+		return nil, errors.Errorf("%v: Layout is held by Job %v", Errors_LOCKED, holder)
+	}
+
+	defer highbrow.Try(saveRetry, func() error {
+		return s.store.Unlock(key)
+	})
+
+	queue, err := s.queued(wID, lID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(queue) > 0 {
+		return nil, errors.Errorf("%v: Layout has %d queued Jobs", Errors_LOCKED, len(queue))
+	}
+
+	stateKey := filepath.Join(types.STATE, wID, lID)
+
+	if !in.Force {
+		destroyed, err := s.destroyed(wID, lID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !destroyed {
+			state, err := s.store.GetKey(stateKey)
+			if err != nil {
+				return nil, errors.Wrap(err, "Cannot get state")
+			}
+
+			n, err := runner.StateResources(state)
+			if err != nil {
+				return nil, err
+			}
+
+			if n > 0 {
+				return nil, errors.Errorf(
+					"%v: State still has %d resources, destroy the Layout first", Errors_NOT_ALLOWED, n)
+			}
+		}
+	}
+
+	// The Layout tree holds its vars, watch, drift, queue and state versions.
+	prefixes := []string{
+		layout.MakePath(types.MakeTree(wID)),
+		(&types.Job{LayoutId: lID}).MakePath(types.MakeTree(wID)),
+		stateKey,
+	}
+
+	for _, p := range prefixes {
+		if err := s.store.DeleteKeys(p); err != nil {
+			return nil, errors.Wrapf(err, "Cannot delete %v", p)
+		}
+	}
+
+	if err := s.store.DeleteKey(stateKey); err != nil {
+		return nil, errors.Wrap(err, "Cannot delete state")
+	}
+
+	if in.Force {
+		s.audit(wID, &types.AuditEvent{
+			Action:   "DeleteLayout",
+			Actor:    caller(ctx),
+			LayoutId: lID,
+			Detail:   "Deleted with Force",
+		})
+	}
+
+	return &Ok{}, nil
+}
+
+// destroyed tells if the last change to a Layout was a complete destroy.
+// A destroy limited to some targets leaves the rest of the resources behind.
+func (s *Server) destroyed(wID, lID string) (bool, error) {
+	j, err := s.lastChange(wID, lID)
+	if err != nil || j == nil {
+		return false, err
+	}
+
+	return j.Op == int32(Operation_DESTROY) && len(j.Targets) == 0, nil
+}
+
+// DeleteWorkspace along with its vars, though not its audit events.
+// Refused while any Layout remains in it.
+func (s *Server) DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest) (*Ok, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	w := types.Workspace(in.Id)
+	if err := s.store.Get(&w, types.MakeTree(in.Id)); err != nil {
+		return nil, errors.Wrap(err, Errors_NOT_FOUND.String())
+	}
+
+	lIDs, err := s.layoutIDs(in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lIDs) > 0 {
+		return nil, errors.Errorf("%v: Workspace still has %d Layouts", Errors_NOT_ALLOWED, len(lIDs))
+	}
+
+	if err := s.store.DeleteKeys(w.MakePath(nil)); err != nil {
+		return nil, errors.Wrap(err, "Cannot delete workspace")
+	}
+
+	// Audit events are kept apart from the Workspace, and outlive it.
+	s.audit(in.Id, &types.AuditEvent{
+		Action: "DeleteWorkspace",
+		Actor:  caller(ctx),
+	})
+
+	return &Ok{}, nil
+}
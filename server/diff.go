@@ -0,0 +1,191 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// DiffLayoutVersions compares the Plan of two versions of a Layout, file by file.
+// By default the version that was last applied is compared with the latest one, which
+// is what an ApplyLayout is about to change. A Layout that was never applied is compared
+// with nothing, so all of its files show up as ADDED.
+func (s *Server) DiffLayoutVersions(ctx context.Context, in *DiffLayoutVersionsRequest) (*LayoutDiff, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	tree := types.MakeTree(in.WorkspaceId)
+	from, to := in.From, in.To
+
+	if to == "" {
+		versions, err := s.store.GetVersions(&types.Layout{Id: in.Id}, tree)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := newestFirst(versions)
+		if len(ids) == 0 {
+			return nil, errors.Errorf("%v: Layout %v has no versions", Errors_NOT_FOUND, in.Id)
+		}
+
+		to = ids[0]
+	}
+
+	if from == "" {
+		j, err := s.lastApplied(in.WorkspaceId, in.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		if j != nil {
+			from = j.LayoutVersion
+		}
+	}
+
+	toPlan, err := s.layoutPlan(in.WorkspaceId, in.Id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromPlan := map[string]json.RawMessage{}
+	if from != "" {
+		if fromPlan, err = s.layoutPlan(in.WorkspaceId, in.Id, from); err != nil {
+			return nil, err
+		}
+	}
+
+	return &LayoutDiff{From: from, To: to, Files: diffPlans(fromPlan, toPlan)}, nil
+}
+
+// layoutPlan returns the Plan of a version of a Layout.
+func (s *Server) layoutPlan(wID, lID, version string) (map[string]json.RawMessage, error) {
+	l := types.Layout{Id: lID}
+	if err := s.store.GetVersion(&l, types.MakeTree(wID), version); err != nil {
+		return nil, errors.Wrap(err, Errors_NOT_FOUND.String())
+	}
+
+	return l.Plan, nil
+}
+
+// diffPlans lists the files that differ between two Plans, sorted by name.
+func diffPlans(from, to map[string]json.RawMessage) []*FileDiff {
+	names := map[string]bool{}
+	for n := range from {
+		names[n] = true
+	}
+
+	for n := range to {
+		names[n] = true
+	}
+
+	sorted := []string{}
+	for n := range names {
+		sorted = append(sorted, n)
+	}
+	sort.Strings(sorted)
+
+	files := []*FileDiff{}
+	for _, n := range sorted {
+		a, inFrom := from[n]
+		b, inTo := to[n]
+
+		switch {
+		case !inFrom:
+			files = append(files, &FileDiff{Name: n, Change: FileChange_ADDED})
+		case !inTo:
+			files = append(files, &FileDiff{Name: n, Change: FileChange_REMOVED})
+		default:
+			if paths := diffFile(a, b); len(paths) > 0 {
+				files = append(files, &FileDiff{Name: n, Change: FileChange_CHANGED, Paths: paths})
+			}
+		}
+	}
+
+	return files
+}
+
+// diffFile returns the JSON Pointers to the values that differ between two files.
+// Files that are not JSON are compared as they are, the whole file being the one value.
+func diffFile(a, b json.RawMessage) []string {
+	var av, bv interface{}
+	if json.Unmarshal(a, &av) != nil || json.Unmarshal(b, &bv) != nil {
+		if string(a) == string(b) {
+			return nil
+		}
+
+		return []string{""}
+	}
+
+	paths := []string{}
+	diffValue("", av, bv, &paths)
+	return paths
+}
+
+// diffValue appends the paths under p that differ between a and b.
+// Keys present on one side only are reported as a whole.
+func diffValue(p string, a, b interface{}, paths *[]string) {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := []string{}
+		for k := range av {
+			keys = append(keys, k)
+		}
+
+		for k := range bv {
+			if _, ok := av[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			diffValue(p+"/"+escapePointer(k), av[k], bv[k], paths)
+		}
+
+		return
+
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+
+		n := len(av)
+		if len(bv) > n {
+			n = len(bv)
+		}
+
+		for i := 0; i < n; i++ {
+			ip := p + "/" + strconv.Itoa(i)
+			if i >= len(av) || i >= len(bv) {
+				*paths = append(*paths, ip)
+				continue
+			}
+
+			diffValue(ip, av[i], bv[i], paths)
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		*paths = append(*paths, p)
+	}
+}
+
+// escapePointer escapes a key to be used in a JSON Pointer, as per RFC 6901.
+func escapePointer(k string) string {
+	return strings.Replace(strings.Replace(k, "~", "~0", -1), "/", "~1", -1)
+}
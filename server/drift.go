@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gorhill/cronexpr"
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// SetDriftSchedule of a Layout, a cron expression for how often the Layout is planned
+// to find out if the infrastructure has drifted from it. An empty Cron stops the checks.
+func (s *Server) SetDriftSchedule(ctx context.Context, in *DriftScheduleRequest) (*Ok, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	if in.Cron != "" {
+		if _, err := cronexpr.Parse(in.Cron); err != nil {
+			return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+		}
+	}
+
+	layout := types.Layout{Id: in.Id}
+	if err := s.store.Get(&layout, types.MakeTree(in.WorkspaceId)); err != nil {
+		return nil, err
+	}
+
+	// The first check is due at the next scheduled time from now.
+	ds := types.DriftSchedule{Cron: in.Cron, LastRun: time.Now().UnixNano()}
+	if err := storage.SaveLatest(s.store, &ds, types.MakeTree(in.WorkspaceId, in.Id)); err != nil {
+		return nil, err
+	}
+
+	return &Ok{}, nil
+}
+
+// layoutDrift returns the drift schedule of a Layout along with its latest check.
+// Returns nil if the Layout has never been scheduled for a check.
+func (s *Server) layoutDrift(wID, lID string) (*LayoutDrift, error) {
+	tree := types.MakeTree(wID, lID)
+
+	ds := types.DriftSchedule{}
+	if err := s.store.Get(&ds, tree); err != nil {
+		if !strings.Contains(err.Error(), "Missing") {
+			return nil, errors.Wrap(err, "Cannot get drift schedule")
+		}
+
+		return nil, nil
+	}
+
+	out := &LayoutDrift{Schedule: ds.Cron}
+
+	d := types.Drift{}
+	if err := s.store.Get(&d, tree); err != nil {
+		if !strings.Contains(err.Error(), "Missing") {
+			return nil, errors.Wrap(err, "Cannot get drift")
+		}
+
+		return out, nil
+	}
+
+	out.JobId = d.JobId
+	out.Drifted = d.Drifted
+	out.Resources = d.Resources
+	out.CheckedAt = d.CheckedAt
+	return out, nil
+}
+
+// checkDrift of a Layout, if a check is due as per its schedule.
+// The check is a plan only Job, queued like any other, of what was last applied.
+// A Layout that was never applied, or was destroyed since, has nothing to drift from.
+func (s *Server) checkDrift(wID, lID string, now time.Time) error {
+	tree := types.MakeTree(wID, lID)
+
+	ds := types.DriftSchedule{}
+	if err := s.store.Get(&ds, tree); err != nil {
+		if !strings.Contains(err.Error(), "Missing") {
+			return errors.Wrap(err, "Cannot get drift schedule")
+		}
+
+		return nil
+	}
+
+	if ds.Cron == "" {
+		return nil
+	}
+
+	expr, err := cronexpr.Parse(ds.Cron)
+	if err != nil {
+		return errors.Wrapf(err, "Invalid drift schedule %v", ds.Cron)
+	}
+
+	next := expr.Next(time.Unix(0, ds.LastRun))
+	if next.IsZero() || next.After(now) {
+		return nil
+	}
+
+	// The previous check is yet to finish, the next one waits for it.
+	if ds.LastJobId != "" {
+		j, err := s.getJob(wID, lID, ds.LastJobId)
+		if err != nil {
+			return err
+		}
+
+		if !finished(JobState(j.Status)) {
+			return nil
+		}
+	}
+
+	applied, err := s.lastApplied(wID, lID)
+	if err != nil {
+		return err
+	}
+
+	ds.LastRun = now.UnixNano()
+	ds.LastJobId = ""
+
+	var jobErr error
+	if applied != nil {
+		j := &types.Job{
+			LayoutId:      lID,
+			LayoutVersion: applied.LayoutVersion,
+			VarsVersion:   applied.VarsVersion,
+			Op:            int32(Operation_APPLY),
+			Drift:         true,
+		}
+
+		_, jobErr = s.opLayout(wID, j, nil)
+		ds.LastJobId = j.Id
+	}
+
+	if err := storage.SaveLatest(s.store, &ds, tree); err != nil {
+		return err
+	}
+
+	return jobErr
+}
+
+// lastApplied returns the Job that last applied a Layout.
+// Returns nil if the Layout was never applied, or was destroyed since.
+func (s *Server) lastApplied(wID, lID string) (*types.Job, error) {
+	j, err := s.lastChange(wID, lID)
+	if err != nil || j == nil || j.Op != int32(Operation_APPLY) {
+		return nil, err
+	}
+
+	return j, nil
+}
+
+// lastChange returns the last Job that applied or destroyed a Layout, nil if none did.
+func (s *Server) lastChange(wID, lID string) (*types.Job, error) {
+	ids, err := s.store.GetVersions(&types.Job{LayoutId: lID}, types.MakeTree(wID))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range newestFirst(ids) {
+		j, err := s.getJob(wID, lID, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if j.Dry || j.Drift || JobState(j.Status) != JobState_DONE {
+			continue
+		}
+
+		switch Operation(j.Op) {
+		case Operation_APPLY, Operation_DESTROY:
+			return j, nil
+		}
+	}
+
+	return nil, nil
+}
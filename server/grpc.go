@@ -1,7 +1,9 @@
 package server
 
 import (
+	"bytes"
 	"io"
+	"io/ioutil"
 	"log"
 
 	"github.com/getsentry/raven-go"
@@ -27,6 +29,8 @@ var (
 	twoFAConfig = kingpin.Flag("totp-config", "Config file for 2FA").File()
 	sentryDsn   = kingpin.Flag("sentry-dsn", "Sentry Dsn").Envar("SENTRY_DSN").String()
 	environment = kingpin.Flag("environment", "environment").Envar("ENV").String()
+	admins      = kingpin.Flag("admin", "Common Name of a client certificate allowed admin operations. Repeatable.").
+			Envar("ADMINS").Strings()
 )
 
 func customFunc(t interface{}) error {
@@ -53,10 +57,23 @@ func Grpc() *grpc.Server {
 		twofaIO = io.ReadCloser(*twoFAConfig)
 	}
 
+	// The config is read once, for both the unary and the stream 2FA interceptors.
+	twofaConf, err := ioutil.ReadAll(twofaIO)
+	if err != nil {
+		log.Println(err)
+	}
+	twofaIO.Close()
+
 	unaries := []grpc.UnaryServerInterceptor{
 		grpc_recovery.UnaryServerInterceptor(opts...),
 		middleware.UnaryServerInterceptor(*support),
-		middleware.TwoFAInterceptor(twofaIO, validator),
+		middleware.TwoFAInterceptor(ioutil.NopCloser(bytes.NewReader(twofaConf)), validator),
+	}
+
+	streams := []grpc.StreamServerInterceptor{
+		grpc_recovery.StreamServerInterceptor(opts...),
+		middleware.StreamServerInterceptor(*support),
+		middleware.TwoFAStreamInterceptor(ioutil.NopCloser(bytes.NewReader(twofaConf)), validator),
 	}
 
 	sopts := []grpc.ServerOption{}
@@ -71,9 +88,10 @@ func Grpc() *grpc.Server {
 		sopts = append(sopts, grpc.Creds(creds))
 	}
 
-	sopts = append(sopts, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaries...)))
-
-	return grpc.NewServer(
+	sopts = append(sopts,
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaries...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streams...)),
 	)
+
+	return grpc.NewServer(sopts...)
 }
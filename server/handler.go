@@ -7,11 +7,17 @@ import (
 	"log"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/meson10/highbrow"
 	"github.com/pkg/errors"
 	"github.com/tsocial/tessellate/dispatcher"
+	"github.com/tsocial/tessellate/redact"
+	"github.com/tsocial/tessellate/runner"
+	"github.com/tsocial/tessellate/storage"
 	"github.com/tsocial/tessellate/storage/types"
 )
 
@@ -19,17 +25,9 @@ const (
 	saveRetry = 5
 	state     = "state"
 	drySuffix = "-dry"
-)
-
-type Output struct {
-	Outputs map[string]struct {
-		Value interface{} `json:"value"`
-	} `json:"outputs"`
-}
 
-type StateStruct struct {
-	Modules []*Output `json:"modules"`
-}
+	defaultPageSize = 20
+)
 
 // SaveWorkspace under workspaces/ .
 func (s *Server) SaveWorkspace(ctx context.Context, in *SaveWorkspaceRequest) (*Ok, error) {
@@ -55,7 +53,13 @@ func (s *Server) SaveWorkspace(ctx context.Context, in *SaveWorkspaceRequest) (*
 		}
 	}
 
-	// Save the workspace and the vars.
+	// Save the vars under their lock, so they do not clobber a change in progress.
+	unlock, err := s.lockVars(in.Id, "SaveWorkspace")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
 	if err := s.store.Save(&vars, tree); err != nil {
 		return nil, err
 	}
@@ -120,7 +124,7 @@ func (s *Server) getWorkspace(id string) (*Workspace, error) {
 		return nil, err
 	}
 
-	vars.RedactSecrets()
+	vars.RedactSecrets(s.redactor)
 	bytes, _ := vars.Marshal()
 
 	// Return the workspace, with latest version and vars.
@@ -230,31 +234,50 @@ func (s *Server) GetLayout(ctx context.Context, in *LayoutRequest) (*Layout, err
 	// Marshal plan and vars.
 	pBytes, _ := json.Marshal(layout.Plan)
 
+	drift, err := s.layoutDrift(in.WorkspaceId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return the layout instance.
 	lay := Layout{
 		Workspaceid: in.WorkspaceId,
 		Id:          layout.Id,
 		Status:      Status(layout.Status),
 		Plan:        pBytes,
+		Drift:       drift,
+
+		SourceWorkspaceId: layout.SourceWorkspace,
+		SourceVersion:     layout.SourceVersion,
 	}
 
 	return &lay, nil
 }
 
 // Operation layout for APPLY and DESTROY operations on the layout.
-func (s *Server) opLayout(wID, lID string, op int32, vars []byte, dry bool, retry int64) (*JobStatus, error) {
+// Creates a Job out of j, which carries the details of the operation. Layout and Vars
+// versions are set to the latest ones, unless j already carries them.
+// The Job is queued behind any other Job of the Layout, and dispatched right away if
+// there are none.
+func (s *Server) opLayout(wID string, j *types.Job, vars []byte) (*JobStatus, error) {
+	lID := j.LayoutId
 	lyt := types.Layout{Id: lID}
 	tree := types.MakeTree(wID)
 	layoutTree := types.MakeTree(wID, lID)
 	// GET versions of the layout.
-	versions, err := s.store.GetVersions(&lyt, tree)	
+	versions, err := s.store.GetVersions(&lyt, tree)
 	if err != nil {
 		return nil, err
 	}
 
+	ids := newestFirst(versions)
+	if len(ids) == 0 {
+		return nil, errors.Errorf("%v: Layout %v has no versions", Errors_NOT_FOUND, lID)
+	}
+
 	v := types.Vars{}
 
-	var varID string
+	varID := j.VarsVersion
 	// todo check if vars are empty.
 	if vars != nil {
 		// Unmarshal in vars in v.
@@ -271,31 +294,44 @@ func (s *Server) opLayout(wID, lID string, op int32, vars []byte, dry bool, retr
 	}
 
 	// Return the job instance for layout with latest version of vars and layout.
-	j := types.Job{
-		LayoutId:      lID,
-		LayoutVersion: versions[len(versions)-2],
-		Status:        int32(JobState_PENDING),
-		VarsVersion:   varID,
-		Op:            op,
-		Dry:           dry,
-		Retry:         retry,
-	}
-	// Save this job in workspace tree.
-	if err := s.store.Save(&j, tree); err != nil {
+	if j.LayoutVersion == "" {
+		j.LayoutVersion = ids[0]
+	}
+
+	if !hasVersion(ids, j.LayoutVersion) {
+		return nil, errors.Errorf("%v: Layout %v has no version %v", Errors_NOT_FOUND, lID, j.LayoutVersion)
+	}
+
+	j.VarsVersion = varID
+
+	locked, err := s.admit(wID, j)
+	if err != nil {
 		return nil, err
 	}
+
 	job := &JobStatus{Id: j.Id, Status: JobState(j.Status)}
 
-	// Lock for workspace and layout.
-	key := fmt.Sprintf("%v-%v", wID, lID)
+	if locked {
+		link, err := s.dispatch(wID, j)
+		job.Status = JobState(j.Status)
+		job.Link = link
+		return job, err
+	}
 
-	if err := highbrow.Try(saveRetry, func() error {
-		return s.store.Lock(key, job.Id)
-	}); err != nil {
-		return nil, err
+	// Layout may have been released in the meantime.
+	next, link, err := s.dispatchNext(wID, lID)
+	if next != nil && next.Id == j.Id {
+		job.Status = JobState(next.Status)
+		job.Link = link
+		return job, err
 	}
-	link, err := dispatcher.Get().Dispatch(wID, &j)
-	job.Id = link
+
+	if err != nil {
+		log.Printf("Cannot dispatch next job of %v/%v: %+v", wID, lID, err)
+	}
+
+	pos, err := s.queuePosition(wID, lID, j.Id)
+	job.QueuePosition = pos
 	return job, err
 }
 
@@ -308,7 +344,72 @@ func (s *Server) ApplyLayout(ctx context.Context, in *ApplyLayoutRequest) (*JobS
 	if !in.Dry && strings.HasSuffix(in.Id, drySuffix) {
 		return nil, errors.New(fmt.Sprintf("Operation not allowed, on %s, use --dry to run a terraform plan", in.Id))
 	}
-	return s.opLayout(in.WorkspaceId, in.Id, int32(Operation_APPLY), in.Vars, in.Dry, in.Retry)
+
+	j := &types.Job{
+		LayoutId: in.Id,
+		Op:       int32(Operation_APPLY),
+		Dry:      in.Dry,
+		Retry:    in.Retry,
+		Targets:  in.Targets,
+		Replace:  in.Replace,
+
+		LayoutVersion: in.LayoutVersion,
+	}
+
+	if in.PlanJobId != "" {
+		if err := s.planJob(in, j); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.opLayout(in.WorkspaceId, j, in.Vars)
+}
+
+// planJob checks that the Job an apply refers to has a plan that can be applied,
+// and pins the apply to the Layout and Vars versions the plan was made with.
+func (s *Server) planJob(in *ApplyLayoutRequest, j *types.Job) error {
+	if in.Dry {
+		return errors.Errorf("%v: A plan cannot be applied with --dry", Errors_NOT_ALLOWED)
+	}
+
+	if in.Vars != nil {
+		return errors.Errorf("%v: Vars cannot be passed along with a plan, the plan's are used", Errors_NOT_ALLOWED)
+	}
+
+	if len(in.Targets) > 0 || len(in.Replace) > 0 {
+		return errors.Errorf("%v: Targets and Replace cannot be passed along with a plan, they are part of it", Errors_NOT_ALLOWED)
+	}
+
+	if in.LayoutVersion != "" {
+		return errors.Errorf("%v: LayoutVersion cannot be passed along with a plan, the plan's is used", Errors_NOT_ALLOWED)
+	}
+
+	pj, err := s.getJob(in.WorkspaceId, in.Id, in.PlanJobId)
+	if err != nil {
+		return err
+	}
+
+	if !pj.Dry || pj.Op != int32(Operation_APPLY) {
+		return errors.Errorf("%v: Job %v is not a dry run", Errors_NOT_ALLOWED, pj.Id)
+	}
+
+	if JobState(pj.Status) != JobState_DONE {
+		return errors.Errorf("%v: Job %v is %v", Errors_NOT_ALLOWED, pj.Id, JobState(pj.Status))
+	}
+
+	b, err := s.store.GetKey(pj.PlanFileKey(types.MakeTree(in.WorkspaceId)))
+	if err != nil {
+		return errors.Wrap(err, "Cannot get plan")
+	}
+
+	if len(b) == 0 {
+		return errors.Errorf("%v: No plan saved for Job %v", Errors_NOT_FOUND, pj.Id)
+	}
+
+	j.PlanJobId = pj.Id
+	j.LayoutVersion = pj.LayoutVersion
+	j.VarsVersion = pj.VarsVersion
+	return nil
 }
 
 // DestroyLayout job.
@@ -317,16 +418,428 @@ func (s *Server) DestroyLayout(ctx context.Context, in *DestroyLayoutRequest) (*
 		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
 	}
 
-	return s.opLayout(in.WorkspaceId, in.Id, int32(Operation_DESTROY), in.Vars, false, in.Retry)
+	j := &types.Job{
+		LayoutId: in.Id,
+		Op:       int32(Operation_DESTROY),
+		Retry:    in.Retry,
+		Targets:  in.Targets,
+	}
+
+	return s.opLayout(in.WorkspaceId, j, in.Vars)
+}
+
+// RefreshLayout updates the state of the layout to match its infrastructure,
+// without making any changes to it.
+func (s *Server) RefreshLayout(ctx context.Context, in *RefreshLayoutRequest) (*JobStatus, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	j := &types.Job{
+		LayoutId: in.Id,
+		Op:       int32(Operation_REFRESH),
+		Retry:    in.Retry,
+		Targets:  in.Targets,
+	}
+
+	return s.opLayout(in.WorkspaceId, j, in.Vars)
+}
+
+// ImportResource that was made outside of the layout, to an address in the layout.
+func (s *Server) ImportResource(ctx context.Context, in *ImportResourceRequest) (*JobStatus, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	j := &types.Job{
+		LayoutId:      in.Id,
+		Op:            int32(Operation_IMPORT),
+		Retry:         in.Retry,
+		ImportAddress: in.Address,
+		ImportId:      in.ResourceId,
+	}
+
+	return s.opLayout(in.WorkspaceId, j, in.Vars)
+}
+
+// RollbackLayout applies the Layout and Vars versions of a previous successful apply.
+func (s *Server) RollbackLayout(ctx context.Context, in *RollbackLayoutRequest) (*JobStatus, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	to, err := s.rollbackTo(in.WorkspaceId, in.Id, in.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	j := &types.Job{
+		LayoutId:      in.Id,
+		Op:            int32(Operation_APPLY),
+		Retry:         in.Retry,
+		LayoutVersion: to.LayoutVersion,
+		VarsVersion:   to.VarsVersion,
+	}
+
+	return s.opLayout(in.WorkspaceId, j, nil)
+}
+
+// rollbackTo finds the last successful apply of a Layout before the Job jID, or before
+// the last successful apply if jID is empty. Applies limited to some targets are left
+// out, as they did not apply the whole of their Layout version.
+func (s *Server) rollbackTo(wID, lID, jID string) (*types.Job, error) {
+	if jID != "" {
+		if _, err := s.getJob(wID, lID, jID); err != nil {
+			return nil, err
+		}
+	}
+
+	ids, err := s.store.GetVersions(&types.Job{LayoutId: lID}, types.MakeTree(wID))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range newestFirst(ids) {
+		if jID != "" && !olderThan(id, jID) {
+			continue
+		}
+
+		j, err := s.getJob(wID, lID, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if j.Op != int32(Operation_APPLY) || j.Dry || j.Drift || len(j.Targets) > 0 ||
+			JobState(j.Status) != JobState_DONE {
+			continue
+		}
+
+		// The last successful apply is what is being rolled back.
+		if jID == "" {
+			jID = j.Id
+			continue
+		}
+
+		return j, nil
+	}
+
+	return nil, errors.Errorf("%v: No successful apply of Layout %v to roll back to", Errors_NOT_FOUND, lID)
 }
 
 // AbortJob to halt.
+// A queued Job is taken off the queue. A dispatched Job is stopped, and the Layout is
+// released for the next Job.
 func (s *Server) AbortJob(ctx context.Context, in *JobRequest) (*Ok, error) {
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
 	}
 
-	return nil, nil
+	j, err := s.getJob(in.WorkspaceId, in.LayoutId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if finished(JobState(j.Status)) {
+		return nil, errors.Errorf("Cannot abort job %v, it is already %v", j.Id, JobState(j.Status))
+	}
+
+	if JobState(j.Status) == JobState_QUEUED {
+		if j, err = s.abortQueued(in.WorkspaceId, in.LayoutId, j.Id); err != nil {
+			return nil, err
+		}
+
+		// Otherwise it was dispatched in the meantime, and is stopped like any other.
+		if JobState(j.Status) == JobState_ABORTED {
+			return &Ok{}, nil
+		}
+	}
+
+	if err := dispatcher.Get().Abort(in.WorkspaceId, j); err != nil {
+		return nil, errors.Wrap(err, "Cannot stop job")
+	}
+
+	j.Status = int32(JobState_ABORTED)
+	if err := s.saveJob(in.WorkspaceId, j); err != nil {
+		return nil, err
+	}
+
+	// UnLock Lock for workspace and layout.
+	key := lockKey(in.WorkspaceId, in.LayoutId)
+	if err := highbrow.Try(saveRetry, func() error {
+		return s.store.Unlock(key)
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, _, err := s.dispatchNext(in.WorkspaceId, in.LayoutId); err != nil {
+		log.Printf("Cannot dispatch next job of %v/%v: %+v", in.WorkspaceId, in.LayoutId, err)
+	}
+
+	return &Ok{}, nil
+}
+
+// GetJob returns the current state of a Job, so that clients can poll it till it's done.
+func (s *Server) GetJob(ctx context.Context, in *JobRequest) (*Job, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	j, err := s.getJob(in.WorkspaceId, in.LayoutId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := jobMessage(in.WorkspaceId, j)
+	if out.Status == JobState_QUEUED {
+		if out.QueuePosition, err = s.queuePosition(in.WorkspaceId, in.LayoutId, j.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// ListJobs that have run against a Layout, newest first.
+// Pass the NextPageToken of a response as PageToken to fetch the page after it.
+func (s *Server) ListJobs(ctx context.Context, in *ListJobsRequest) (*Jobs, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	size := int(in.PageSize)
+	if size == 0 {
+		size = defaultPageSize
+	}
+
+	states := map[JobState]bool{}
+	for _, st := range in.States {
+		states[st] = true
+	}
+
+	tree := types.MakeTree(in.WorkspaceId)
+	ids, err := s.store.GetVersions(&types.Job{LayoutId: in.LayoutId}, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	ids = newestFirst(ids)
+
+	out := &Jobs{Jobs: []*Job{}}
+	for _, id := range ids {
+		if in.PageToken != "" && !olderThan(id, in.PageToken) {
+			continue
+		}
+
+		j, err := s.getJob(in.WorkspaceId, in.LayoutId, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(states) > 0 && !states[JobState(j.Status)] {
+			continue
+		}
+
+		// There is at least one more Job after this page.
+		if len(out.Jobs) == size {
+			out.NextPageToken = out.Jobs[size-1].Id
+			break
+		}
+
+		out.Jobs = append(out.Jobs, jobMessage(in.WorkspaceId, j))
+	}
+
+	return out, nil
+}
+
+// GetPlan made by a dry Job, to review what applying the Layout will do.
+func (s *Server) GetPlan(ctx context.Context, in *JobRequest) (*Plan, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	j, err := s.getJob(in.WorkspaceId, in.LayoutId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !j.Dry && !j.Drift {
+		return nil, errors.Errorf("%v: Job %v is not a dry run", Errors_NOT_ALLOWED, j.Id)
+	}
+
+	b, err := s.store.GetKey(j.PlanKey(types.MakeTree(in.WorkspaceId)))
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot get plan")
+	}
+
+	if len(b) == 0 {
+		return nil, errors.Errorf("%v: No plan saved for Job %v, it is %v", Errors_NOT_FOUND, j.Id, JobState(j.Status))
+	}
+
+	p, err := runner.ParsePlan(b)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &Plan{
+		JobId:   j.Id,
+		Changes: []*ResourceChange{},
+		Add:     int32(p.Add),
+		Change:  int32(p.Change),
+		Destroy: int32(p.Destroy),
+	}
+
+	for _, c := range p.Changes {
+		out.Changes = append(out.Changes, &ResourceChange{
+			Address: c.Address,
+			Type:    c.Type,
+			Name:    c.Name,
+			Action:  Action(Action_value[strings.ToUpper(c.Action)]),
+		})
+	}
+
+	return out, nil
+}
+
+// Interval at which StreamJobLogs looks for new output of a running Job.
+var logPollInterval = time.Second
+
+// StreamJobLogs sends the output of a Job, chunk by chunk, as it is saved by the worker.
+// A running Job is followed till it's over, output of a finished Job is replayed in full.
+func (s *Server) StreamJobLogs(in *JobRequest, stream Tessellate_StreamJobLogsServer) error {
+	if err := in.Validate(); err != nil {
+		return errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	tree := types.MakeTree(in.WorkspaceId)
+	seq := 0
+
+	for {
+		// Read the state before the logs, the worker saves all of them before finishing a Job.
+		j, err := s.getJob(in.WorkspaceId, in.LayoutId, in.Id)
+		if err != nil {
+			return err
+		}
+
+		for {
+			b, err := s.store.GetKey(j.LogKey(tree, seq))
+			if err != nil {
+				return errors.Wrap(err, "Cannot get logs")
+			}
+
+			if len(b) == 0 {
+				break
+			}
+
+			if err := stream.Send(&JobLog{Data: b}); err != nil {
+				return err
+			}
+
+			seq++
+		}
+
+		if finished(JobState(j.Status)) {
+			return nil
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-time.After(logPollInterval):
+		}
+	}
+}
+
+// finished is true for a Job that will not run any further.
+func finished(st JobState) bool {
+	switch st {
+	case JobState_QUEUED, JobState_PENDING, JobState_RUNNING:
+		return false
+	}
+
+	return true
+}
+
+// newestFirst sorts the timestamp versions in a list in descending order.
+// Drops latest, and the keys nested under the same path, like the vars of a Layout.
+func newestFirst(versions []string) []string {
+	ids := make([]string, 0, len(versions))
+	for _, v := range versions {
+		if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+			ids = append(ids, v)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return olderThan(ids[j], ids[i])
+	})
+
+	return ids
+}
+
+// hasVersion tells if version is one of versions.
+func hasVersion(versions []string, version string) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// olderThan compares two timestamp versions.
+func olderThan(a, b string) bool {
+	x, errA := strconv.ParseInt(a, 10, 64)
+	y, errB := strconv.ParseInt(b, 10, 64)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+
+	return x < y
+}
+
+func jobMessage(wID string, j *types.Job) *Job {
+	// Job IDs are the timestamps they were created at.
+	created, _ := strconv.ParseInt(j.Id, 10, 64)
+
+	return &Job{
+		Id:            j.Id,
+		WorkspaceId:   wID,
+		LayoutId:      j.LayoutId,
+		LayoutVersion: j.LayoutVersion,
+		VarsVersion:   j.VarsVersion,
+		Op:            Operation(j.Op),
+		Dry:           j.Dry,
+		Retry:         j.Retry,
+		Status:        JobState(j.Status),
+		Error:         j.Error,
+		StartedAt:     j.StartedAt,
+		EndedAt:       j.EndedAt,
+		CreatedAt:     created,
+		PlanJobId:     j.PlanJobId,
+		StateSerial:   j.StateSerial,
+		Drift:         j.Drift,
+		Targets:       j.Targets,
+		Replace:       j.Replace,
+		ImportAddress: j.ImportAddress,
+		ImportId:      j.ImportId,
+	}
+}
+
+// getJob returns the latest record of a Job.
+// Falls back to the record saved at the time of creation if the Job hasn't moved since.
+func (s *Server) getJob(wID, lID, jID string) (*types.Job, error) {
+	j, err := storage.GetJob(s.store, wID, lID, jID)
+	if err != nil && strings.Contains(err.Error(), "Missing") {
+		return nil, errors.Wrap(err, Errors_NOT_FOUND.String())
+	}
+
+	return j, err
+}
+
+// saveJob saves the Job as a new version in its history.
+func (s *Server) saveJob(wID string, j *types.Job) error {
+	return storage.SaveJob(s.store, wID, j)
 }
 
 // StartWatch to listen to state changes on a Layout
@@ -365,39 +878,112 @@ func (s *Server) saveWatch(wID, lID, success, failure string) (*Ok, error) {
 	return &Ok{}, nil
 }
 
+// GetState of a Layout. Only admins get it as it is, and every such read is audited.
+// Others get it with the sensitive outputs, and the secrets, masked.
 func (s *Server) GetState(ctx context.Context, in *GetStateRequest) (*GetStateResponse, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
 	key := filepath.Join(types.STATE, in.WorkspaceId, in.LayoutId)
 	data, err := s.store.GetKey(key)
 	if err != nil {
 		return nil, err
 	}
 
+	admin, err := requireAdmin(ctx)
+	if err != nil {
+		redacted, err := s.redactState(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return &GetStateResponse{State: redacted}, nil
+	}
+
+	s.audit(in.WorkspaceId, &types.AuditEvent{
+		Action:   "GetState",
+		Actor:    admin,
+		LayoutId: in.LayoutId,
+		Detail:   "Read the state",
+	})
+
 	return &GetStateResponse{
 		State: data,
 	}, nil
 }
 
 func (s *Server) GetOutput(ctx context.Context, in *GetOutputRequest) (*GetOutputResponse, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	return s.getOutput(in, false)
+}
+
+// GetSensitiveOutput is GetOutput without masking the sensitive outputs.
+// Allowed to admins only, and every read is audited.
+func (s *Server) GetSensitiveOutput(ctx context.Context, in *GetOutputRequest) (*GetOutputResponse, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	admin, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.getOutput(in, true)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := "Read the sensitive outputs"
+	if in.Name != "" {
+		detail = fmt.Sprintf("Read the sensitive output %v", in.Name)
+	}
+
+	s.audit(in.WorkspaceId, &types.AuditEvent{
+		Action:   "GetSensitiveOutput",
+		Actor:    admin,
+		LayoutId: in.LayoutId,
+		Detail:   detail,
+	})
+
+	return out, nil
+}
+
+func (s *Server) getOutput(in *GetOutputRequest, sensitive bool) (*GetOutputResponse, error) {
 	key := filepath.Join(types.STATE, in.WorkspaceId, in.LayoutId)
 	data, err := s.store.GetKey(key)
 	if err != nil {
 		return nil, err
 	}
 
-	out := StateStruct{}
-
-	if err := json.Unmarshal(data, &out); err != nil {
+	outputs, err := runner.StateOutputs(data)
+	if err != nil {
 		return nil, err
 	}
 
-	if len(out.Modules) != 1 {
+	if len(outputs) == 0 {
 		return nil, errors.New("No output found")
 	}
 
 	result := map[string]interface{}{}
 
-	for k := range out.Modules[0].Outputs {
-		result[k] = out.Modules[0].Outputs[k].Value
+	for k := range outputs {
+		if in.Name != "" && k != in.Name {
+			continue
+		}
+
+		result[k] = outputs[k].Value
+		if outputs[k].Sensitive && !sensitive {
+			result[k] = redact.Mask
+		}
+	}
+
+	if in.Name != "" && len(result) == 0 {
+		return nil, errors.Errorf("%v: No output %v", Errors_NOT_FOUND, in.Name)
 	}
 
 	outBytes, err := json.Marshal(result)
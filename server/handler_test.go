@@ -2,18 +2,29 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"path"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/tsocial/tessellate/dispatcher"
+	"github.com/tsocial/tessellate/redact"
+	"github.com/tsocial/tessellate/runner"
 	"github.com/tsocial/tessellate/storage"
 	"github.com/tsocial/tessellate/storage/types"
 	"github.com/tsocial/tessellate/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 )
 
 var store storage.Storer
@@ -41,6 +52,20 @@ func TestServer_SaveAndGetWorkspace(t *testing.T) {
 		assert.Nil(t, err, Errors_INVALID_VALUE.String())
 		assert.Equal(t, id, resp.Name)
 	})
+
+	t.Run("Should get the workspace with secrets redacted.", func(t *testing.T) {
+		_, err := server.SaveWorkspace(context.Background(), &SaveWorkspaceRequest{
+			Id:        id,
+			Providers: []byte(`{"db": {"password": "hunter2", "host": "db.local"}, "api_token": "t0k3n"}`),
+		})
+		assert.Nil(t, err)
+
+		resp, err := server.GetWorkspace(context.Background(), &GetWorkspaceRequest{Id: id})
+		assert.Nil(t, err)
+		assert.NotContains(t, string(resp.Vars), "hunter2")
+		assert.NotContains(t, string(resp.Vars), "t0k3n")
+		assert.Contains(t, string(resp.Vars), "db.local")
+	})
 }
 
 func TestServer_SaveAndGetLayout(t *testing.T) {
@@ -215,7 +240,9 @@ func TestServer_SaveAndGetLayout(t *testing.T) {
 
 	lockKey := fmt.Sprintf("%v-%v", workspaceId, layoutId)
 
-	t.Run("Should Lock a run till completed by worker", func(t *testing.T) {
+	var queuedId string
+
+	t.Run("Should Queue a run till the Layout is released by worker", func(t *testing.T) {
 		req := &ApplyLayoutRequest{
 			WorkspaceId: workspaceId,
 			Id:          layoutId,
@@ -223,8 +250,18 @@ func TestServer_SaveAndGetLayout(t *testing.T) {
 			Vars:        vBytes,
 		}
 
-		_, err := server.ApplyLayout(context.Background(), req)
-		assert.NotNil(t, err, fmt.Sprintf("Should have failed with a Lock, key: %s-%s", workspaceId, layoutId))
+		resp, err := server.ApplyLayout(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, JobState_QUEUED, resp.Status)
+		assert.Equal(t, int32(1), resp.QueuePosition)
+		assert.Equal(t, 1, len(jobQueue.Store), fmt.Sprintf("Should not dispatch while Locked, key: %s", lockKey))
+
+		queuedId = resp.Id
+	})
+
+	t.Run("Should not dispatch a queued run while the Layout is Locked", func(t *testing.T) {
+		server.(*Server).dispatchQueued()
+		assert.Equal(t, 1, len(jobQueue.Store))
 	})
 
 	t.Run("Should allow unlocking a Layout", func(t *testing.T) {
@@ -237,6 +274,19 @@ func TestServer_SaveAndGetLayout(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Should dispatch the queued run once the Layout is released", func(t *testing.T) {
+		server.(*Server).dispatchQueued()
+		assert.Equal(t, queuedId, jobQueue.Store[len(jobQueue.Store)-1])
+
+		resp, err := server.GetJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: queuedId})
+		assert.Nil(t, err)
+		assert.Equal(t, JobState_PENDING, resp.Status)
+		assert.Equal(t, int32(0), resp.QueuePosition)
+
+		assert.NotNil(t, store.Lock(lockKey, "test"), "Dispatched run should hold the Lock")
+		assert.Nil(t, store.Unlock(lockKey))
+	})
+
 	t.Run("Should Destroy a layout", func(t *testing.T) {
 		req := &DestroyLayoutRequest{
 			WorkspaceId: workspaceId,
@@ -263,6 +313,60 @@ func TestServer_SaveAndGetLayout(t *testing.T) {
 		assert.Equal(t, int64(0), job.Retry)
 		assert.NotEmpty(t, job.LayoutVersion)
 	})
+
+	t.Run("Should get the state of a job", func(t *testing.T) {
+		jobId := jobQueue.Store[len(jobQueue.Store)-1]
+		req := &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: jobId}
+
+		resp, err := server.GetJob(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, jobId, resp.Id)
+		assert.Equal(t, workspaceId, resp.WorkspaceId)
+		assert.Equal(t, layoutId, resp.LayoutId)
+		assert.Equal(t, JobState_PENDING, resp.Status)
+		assert.Equal(t, Operation_DESTROY, resp.Op)
+		assert.NotEmpty(t, resp.LayoutVersion)
+	})
+
+	t.Run("Should not get a job that doesn't exist", func(t *testing.T) {
+		req := &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: "missing"}
+
+		_, err := server.GetJob(context.Background(), req)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should abort a pending job and release the Lock", func(t *testing.T) {
+		jobId := jobQueue.Store[len(jobQueue.Store)-1]
+		req := &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: jobId}
+
+		resp, err := server.AbortJob(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, &Ok{}, resp)
+		assert.Equal(t, []string{jobId}, jobQueue.Aborted)
+
+		job, err := server.GetJob(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, JobState_ABORTED, job.Status)
+
+		assert.Nil(t, store.Lock(lockKey, "test"))
+		assert.Nil(t, store.Unlock(lockKey))
+	})
+
+	t.Run("Should not abort a job twice", func(t *testing.T) {
+		jobId := jobQueue.Store[len(jobQueue.Store)-1]
+		req := &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: jobId}
+
+		_, err := server.AbortJob(context.Background(), req)
+		assert.NotNil(t, err)
+		assert.Equal(t, []string{jobId}, jobQueue.Aborted)
+	})
+
+	t.Run("Should not abort a job that doesn't exist", func(t *testing.T) {
+		req := &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: "missing"}
+
+		_, err := server.AbortJob(context.Background(), req)
+		assert.NotNil(t, err)
+	})
 }
 
 func TestServer_SaveAndGetLayout_Dry(t *testing.T) {
@@ -442,7 +546,7 @@ func TestServer_GetOutput(t *testing.T) {
 	resp, err := server.GetOutput(context.Background(), req)
 	assert.Nil(t, err)
 
-	s := StateStruct{}
+	s := runner.StateStruct{}
 	err = json.Unmarshal(valBytes, &s)
 	assert.Nil(t, err)
 
@@ -456,6 +560,86 @@ func TestServer_GetOutput(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, expected, outMap)
+
+	getOutput := func(f func(context.Context, *GetOutputRequest) (*GetOutputResponse, error), file, name string) (map[string]interface{}, error) {
+		b, err := ioutil.ReadFile(file)
+		assert.Nil(t, err)
+		assert.Nil(t, store.SaveKey(key, b))
+
+		resp, err := f(adminCtx("alice"), &GetOutputRequest{WorkspaceId: workspace, LayoutId: layout, Name: name})
+		if err != nil {
+			return nil, err
+		}
+
+		out := map[string]interface{}{}
+		assert.Nil(t, json.Unmarshal(resp.Output, &out))
+		return out, nil
+	}
+
+	t.Run("Should get the outputs of a modern state, masking sensitive ones", func(t *testing.T) {
+		out, err := getOutput(server.GetOutput, "./testdata/output_v4.tfstate", "")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"ips":      []interface{}{"10.0.0.1", "10.0.0.2"},
+			"address":  "db_address",
+			"password": redact.Mask,
+		}, out)
+	})
+
+	*admins = []string{"alice"}
+	defer func() { *admins = nil }()
+
+	t.Run("Should get the sensitive outputs when asked for", func(t *testing.T) {
+		out, err := getOutput(server.GetSensitiveOutput, "./testdata/output_v4.tfstate", "")
+		assert.Nil(t, err)
+		assert.Equal(t, "hunter2", out["password"])
+
+		events, err := server.ListAuditEvents(adminCtx("alice"), &GetWorkspaceRequest{Id: workspace})
+		assert.Nil(t, err)
+		if assert.NotEmpty(t, events.Events) {
+			assert.Equal(t, "GetSensitiveOutput", events.Events[0].Action)
+			assert.Equal(t, "alice", events.Events[0].Actor)
+		}
+	})
+
+	t.Run("Should get the sensitive outputs for admins only", func(t *testing.T) {
+		_, err := server.GetSensitiveOutput(context.Background(), &GetOutputRequest{WorkspaceId: workspace, LayoutId: layout})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should get the outputs of nested modules", func(t *testing.T) {
+		out, err := getOutput(server.GetOutput, "./testdata/output_modules.tfstate", "")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"address":            "db_address",
+			"module.db.password": redact.Mask,
+		}, out)
+	})
+
+	t.Run("Should get a single output by name", func(t *testing.T) {
+		out, err := getOutput(server.GetOutput, "./testdata/output_v4.tfstate", "address")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"address": "db_address"}, out)
+
+		out, err = getOutput(server.GetSensitiveOutput, "./testdata/output_modules.tfstate", "module.db.password")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"module.db.password": "hunter2"}, out)
+	})
+
+	t.Run("Should raise an error for an output that doesn't exist", func(t *testing.T) {
+		_, err := getOutput(server.GetOutput, "./testdata/output_v4.tfstate", "missing")
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+		}
+	})
+
+	t.Run("Should raise an error for a layout that was never applied", func(t *testing.T) {
+		assert.Nil(t, store.SaveKey(key, []byte{}))
+		_, err := server.GetOutput(context.Background(), &GetOutputRequest{WorkspaceId: workspace, LayoutId: layout})
+		assert.NotNil(t, err)
+	})
 }
 
 func TestServer_SaveApplyAndDestroyLayoutWithRetry(t *testing.T) {
@@ -585,3 +769,1914 @@ func TestServer_SaveApplyAndDestroyLayoutWithRetry(t *testing.T) {
 		assert.NotEmpty(t, job.LayoutVersion)
 	})
 }
+
+func TestServer_ListJobs(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	tree := types.MakeTree(workspaceId)
+
+	ids := []string{}
+	for _, op := range []Operation{Operation_APPLY, Operation_DESTROY, Operation_APPLY} {
+		j := types.Job{LayoutId: layoutId, Op: int32(op), Status: int32(JobState_PENDING)}
+		assert.Nil(t, store.Save(&j, tree))
+		ids = append(ids, j.Id)
+	}
+
+	done := types.Job{Id: ids[1], LayoutId: layoutId, Op: int32(Operation_DESTROY), Status: int32(JobState_DONE)}
+	assert.Nil(t, store.Save(&types.JobHistory{Job: &done}, tree))
+
+	t.Run("Should list all the jobs, newest first", func(t *testing.T) {
+		req := &ListJobsRequest{WorkspaceId: workspaceId, LayoutId: layoutId}
+		resp, err := server.ListJobs(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Empty(t, resp.NextPageToken)
+
+		assert.Equal(t, 3, len(resp.Jobs))
+		assert.Equal(t, ids[2], resp.Jobs[0].Id)
+		assert.Equal(t, ids[1], resp.Jobs[1].Id)
+		assert.Equal(t, ids[0], resp.Jobs[2].Id)
+
+		assert.Equal(t, JobState_DONE, resp.Jobs[1].Status)
+		assert.Equal(t, Operation_DESTROY, resp.Jobs[1].Op)
+		assert.NotZero(t, resp.Jobs[1].CreatedAt)
+	})
+
+	t.Run("Should page through the jobs", func(t *testing.T) {
+		req := &ListJobsRequest{WorkspaceId: workspaceId, LayoutId: layoutId, PageSize: 2}
+		resp, err := server.ListJobs(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(resp.Jobs))
+		assert.Equal(t, ids[1], resp.NextPageToken)
+
+		req.PageToken = resp.NextPageToken
+		resp, err = server.ListJobs(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(resp.Jobs))
+		assert.Equal(t, ids[0], resp.Jobs[0].Id)
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("Should filter jobs by state", func(t *testing.T) {
+		req := &ListJobsRequest{WorkspaceId: workspaceId, LayoutId: layoutId, States: []JobState{JobState_DONE}}
+		resp, err := server.ListJobs(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(resp.Jobs))
+		assert.Equal(t, ids[1], resp.Jobs[0].Id)
+	})
+
+	t.Run("Should return no jobs for a layout that never ran", func(t *testing.T) {
+		req := &ListJobsRequest{WorkspaceId: workspaceId, LayoutId: "missing"}
+		resp, err := server.ListJobs(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Empty(t, resp.Jobs)
+	})
+
+	t.Run("Should not allow a page size over 100", func(t *testing.T) {
+		req := &ListJobsRequest{WorkspaceId: workspaceId, LayoutId: layoutId, PageSize: 101}
+		_, err := server.ListJobs(context.Background(), req)
+		assert.NotNil(t, err)
+	})
+}
+
+type logStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data []byte
+}
+
+func (s *logStream) Send(l *JobLog) error {
+	s.data = append(s.data, l.Data...)
+	return nil
+}
+
+func (s *logStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestServer_StreamJobLogs(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	tree := types.MakeTree(workspaceId)
+
+	logPollInterval = 10 * time.Millisecond
+
+	newJob := func() *types.Job {
+		j := types.Job{LayoutId: layoutId, Status: int32(JobState_PENDING)}
+		assert.Nil(t, store.Save(&j, tree))
+		return &j
+	}
+
+	t.Run("Should replay the logs of a finished job", func(t *testing.T) {
+		j := newJob()
+		assert.Nil(t, store.SaveKey(j.LogKey(tree, 0), []byte("Plan: 1 to add\n")))
+		assert.Nil(t, store.SaveKey(j.LogKey(tree, 1), []byte("Apply complete!\n")))
+
+		j.Status = int32(JobState_DONE)
+		assert.Nil(t, store.Save(&types.JobHistory{Job: j}, tree))
+
+		s := &logStream{ctx: context.Background()}
+		err := server.(*Server).StreamJobLogs(&JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: j.Id}, s)
+		assert.Nil(t, err)
+		assert.Equal(t, "Plan: 1 to add\nApply complete!\n", string(s.data))
+	})
+
+	t.Run("Should follow a running job till it's done", func(t *testing.T) {
+		j := newJob()
+		j.Status = int32(JobState_RUNNING)
+		assert.Nil(t, store.Save(&types.JobHistory{Job: j}, tree))
+		assert.Nil(t, store.SaveKey(j.LogKey(tree, 0), []byte("Refreshing state...\n")))
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			store.SaveKey(j.LogKey(tree, 1), []byte("Apply complete!\n"))
+
+			j.Status = int32(JobState_DONE)
+			store.Save(&types.JobHistory{Job: j}, tree)
+		}()
+
+		s := &logStream{ctx: context.Background()}
+		err := server.(*Server).StreamJobLogs(&JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: j.Id}, s)
+		assert.Nil(t, err)
+		assert.Equal(t, "Refreshing state...\nApply complete!\n", string(s.data))
+	})
+
+	t.Run("Should stop following when the client goes away", func(t *testing.T) {
+		j := newJob()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		s := &logStream{ctx: ctx}
+		err := server.(*Server).StreamJobLogs(&JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: j.Id}, s)
+		assert.NotNil(t, err)
+		assert.Empty(t, s.data)
+	})
+
+	t.Run("Should raise an error for a job that doesn't exist", func(t *testing.T) {
+		s := &logStream{ctx: context.Background()}
+		err := server.(*Server).StreamJobLogs(&JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: "missing"}, s)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestServer_GetPlan(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	tree := types.MakeTree(workspaceId)
+
+	newJob := func(dry bool) *types.Job {
+		j := types.Job{LayoutId: layoutId, Dry: dry, Status: int32(JobState_DONE)}
+		assert.Nil(t, store.Save(&j, tree))
+		return &j
+	}
+
+	t.Run("Should get the changes of a plan", func(t *testing.T) {
+		j := newJob(true)
+
+		b, err := ioutil.ReadFile("../runner/testdata/plan.json")
+		assert.Nil(t, err)
+		assert.Nil(t, store.SaveKey(j.PlanKey(tree), b))
+
+		resp, err := server.GetPlan(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: j.Id})
+		assert.Nil(t, err)
+
+		assert.Equal(t, j.Id, resp.JobId)
+		assert.Equal(t, int32(2), resp.Add)
+		assert.Equal(t, int32(1), resp.Change)
+		assert.Equal(t, int32(2), resp.Destroy)
+
+		assert.Equal(t, 4, len(resp.Changes))
+		assert.Equal(t, &ResourceChange{
+			Address: "aws_instance.db",
+			Type:    "aws_instance",
+			Name:    "db",
+			Action:  Action_REPLACE,
+		}, resp.Changes[2])
+		assert.Equal(t, Action_DELETE, resp.Changes[3].Action)
+	})
+
+	t.Run("Should raise an error for a job that is not a dry run", func(t *testing.T) {
+		j := newJob(false)
+
+		_, err := server.GetPlan(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: j.Id})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should raise an error if the plan is yet to be saved", func(t *testing.T) {
+		j := newJob(true)
+
+		_, err := server.GetPlan(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: j.Id})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+	})
+}
+
+func TestServer_ApplyPlan(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	tree := types.MakeTree(workspaceId)
+	lockKey := fmt.Sprintf("%v-%v", workspaceId, layoutId)
+
+	jobQueue := dispatcher.NewInMemory()
+	dispatcher.Set(jobQueue)
+
+	lBytes, err := ioutil.ReadFile("../runner/testdata/sleep.tf.json")
+	assert.Nil(t, err)
+
+	pBytes, _ := json.Marshal(map[string]json.RawMessage{"sleep.tf.json": uglyJson(lBytes)})
+	_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+	assert.Nil(t, err)
+
+	resp, err := server.ApplyLayout(context.Background(), &ApplyLayoutRequest{WorkspaceId: workspaceId, Id: layoutId, Dry: true})
+	assert.Nil(t, err)
+	assert.Nil(t, store.Unlock(lockKey))
+
+	planJob, err := server.(*Server).getJob(workspaceId, layoutId, resp.Id)
+	assert.Nil(t, err)
+
+	apply := func(req *ApplyLayoutRequest) (*JobStatus, error) {
+		req.WorkspaceId = workspaceId
+		req.Id = layoutId
+		return server.ApplyLayout(context.Background(), req)
+	}
+
+	t.Run("Should not apply a plan that is yet to finish", func(t *testing.T) {
+		_, err := apply(&ApplyLayoutRequest{PlanJobId: planJob.Id})
+		assert.NotNil(t, err)
+	})
+
+	planJob.Status = int32(JobState_DONE)
+	assert.Nil(t, store.Save(&types.JobHistory{Job: planJob}, tree))
+
+	t.Run("Should not apply a job that saved no plan", func(t *testing.T) {
+		_, err := apply(&ApplyLayoutRequest{PlanJobId: planJob.Id})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+	})
+
+	assert.Nil(t, store.SaveKey(planJob.PlanFileKey(tree), []byte("binary plan")))
+
+	t.Run("Should not apply a plan with --dry", func(t *testing.T) {
+		_, err := apply(&ApplyLayoutRequest{PlanJobId: planJob.Id, Dry: true})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should not apply a plan with vars", func(t *testing.T) {
+		_, err := apply(&ApplyLayoutRequest{PlanJobId: planJob.Id, Vars: []byte(`{"a": 1}`)})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should not apply a plan with targets", func(t *testing.T) {
+		_, err := apply(&ApplyLayoutRequest{PlanJobId: planJob.Id, Targets: []string{"null_resource.sleep"}})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+	})
+
+	t.Run("Should not apply a plan that doesn't exist", func(t *testing.T) {
+		_, err := apply(&ApplyLayoutRequest{PlanJobId: "missing"})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should apply a plan", func(t *testing.T) {
+		// Another version of the layout is saved after the plan was made.
+		_, err := server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+		assert.Nil(t, err)
+
+		resp, err := apply(&ApplyLayoutRequest{PlanJobId: planJob.Id})
+		assert.Nil(t, err)
+		assert.Nil(t, store.Unlock(lockKey))
+
+		j, err := server.GetJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: resp.Id})
+		assert.Nil(t, err)
+		assert.Equal(t, planJob.Id, j.PlanJobId)
+		assert.Equal(t, planJob.LayoutVersion, j.LayoutVersion)
+		assert.Equal(t, false, j.Dry)
+		assert.Equal(t, Operation_APPLY, j.Op)
+	})
+
+	t.Run("Should not apply a job that is not a dry run", func(t *testing.T) {
+		resp, err := apply(&ApplyLayoutRequest{})
+		assert.Nil(t, err)
+		assert.Nil(t, store.Unlock(lockKey))
+
+		j, err := server.(*Server).getJob(workspaceId, layoutId, resp.Id)
+		assert.Nil(t, err)
+
+		j.Status = int32(JobState_DONE)
+		assert.Nil(t, store.Save(&types.JobHistory{Job: j}, tree))
+
+		_, err = apply(&ApplyLayoutRequest{PlanJobId: j.Id})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestServer_Targets(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	lockKey := fmt.Sprintf("%v-%v", workspaceId, layoutId)
+
+	jobQueue := dispatcher.NewInMemory()
+	dispatcher.Set(jobQueue)
+
+	lBytes, err := ioutil.ReadFile("../runner/testdata/sleep.tf.json")
+	assert.Nil(t, err)
+
+	pBytes, _ := json.Marshal(map[string]json.RawMessage{"sleep.tf.json": uglyJson(lBytes)})
+	_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+	assert.Nil(t, err)
+
+	getJob := func(id string) *Job {
+		j, err := server.GetJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: id})
+		assert.Nil(t, err)
+		return j
+	}
+
+	t.Run("Should apply a layout to targets only", func(t *testing.T) {
+		targets := []string{
+			"null_resource.sleep",
+			"null_resource.sleep[0]",
+			`module.app["blue"].aws_instance.web`,
+			"module.app",
+			"data.aws_ami.ubuntu",
+		}
+		replace := []string{"module.db[1].aws_instance.primary"}
+
+		resp, err := server.ApplyLayout(context.Background(), &ApplyLayoutRequest{
+			WorkspaceId: workspaceId,
+			Id:          layoutId,
+			Targets:     targets,
+			Replace:     replace,
+		})
+		assert.Nil(t, err)
+		assert.Nil(t, store.Unlock(lockKey))
+
+		j := getJob(resp.Id)
+		assert.Equal(t, targets, j.Targets)
+		assert.Equal(t, replace, j.Replace)
+	})
+
+	t.Run("Should destroy only the targets of a layout", func(t *testing.T) {
+		resp, err := server.DestroyLayout(context.Background(), &DestroyLayoutRequest{
+			WorkspaceId: workspaceId,
+			Id:          layoutId,
+			Targets:     []string{"null_resource.sleep"},
+		})
+		assert.Nil(t, err)
+		assert.Nil(t, store.Unlock(lockKey))
+
+		assert.Equal(t, []string{"null_resource.sleep"}, getJob(resp.Id).Targets)
+	})
+
+	t.Run("Should raise validation error for a malformed address", func(t *testing.T) {
+		for _, addr := range []string{"", "sleep", "null_resource.sleep; rm -rf /", "-lock=false", "module..sleep"} {
+			_, err := server.ApplyLayout(context.Background(), &ApplyLayoutRequest{
+				WorkspaceId: workspaceId,
+				Id:          layoutId,
+				Targets:     []string{addr},
+			})
+			assert.NotNil(t, err, addr)
+
+			_, err = server.DestroyLayout(context.Background(), &DestroyLayoutRequest{
+				WorkspaceId: workspaceId,
+				Id:          layoutId,
+				Targets:     []string{addr},
+			})
+			assert.NotNil(t, err, addr)
+		}
+	})
+
+	t.Run("Should not replace a data source", func(t *testing.T) {
+		_, err := server.ApplyLayout(context.Background(), &ApplyLayoutRequest{
+			WorkspaceId: workspaceId,
+			Id:          layoutId,
+			Replace:     []string{"data.aws_ami.ubuntu"},
+		})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_INVALID_VALUE.String())
+		}
+	})
+}
+
+func TestServer_RefreshAndImport(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	lockKey := fmt.Sprintf("%v-%v", workspaceId, layoutId)
+
+	jobQueue := dispatcher.NewInMemory()
+	dispatcher.Set(jobQueue)
+
+	lBytes, err := ioutil.ReadFile("../runner/testdata/sleep.tf.json")
+	assert.Nil(t, err)
+
+	pBytes, _ := json.Marshal(map[string]json.RawMessage{"sleep.tf.json": uglyJson(lBytes)})
+	_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+	assert.Nil(t, err)
+
+	getJob := func(id string) *Job {
+		j, err := server.GetJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: id})
+		assert.Nil(t, err)
+		return j
+	}
+
+	t.Run("Should refresh a layout", func(t *testing.T) {
+		resp, err := server.RefreshLayout(context.Background(), &RefreshLayoutRequest{
+			WorkspaceId: workspaceId,
+			Id:          layoutId,
+			Targets:     []string{"null_resource.sleep"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, JobState_PENDING, resp.Status)
+		assert.Nil(t, store.Unlock(lockKey))
+
+		j := getJob(resp.Id)
+		assert.Equal(t, Operation_REFRESH, j.Op)
+		assert.Equal(t, []string{"null_resource.sleep"}, j.Targets)
+		assert.NotEmpty(t, j.LayoutVersion)
+	})
+
+	t.Run("Should import a resource", func(t *testing.T) {
+		resp, err := server.ImportResource(context.Background(), &ImportResourceRequest{
+			WorkspaceId: workspaceId,
+			Id:          layoutId,
+			Address:     `module.app.aws_instance.web["a"]`,
+			ResourceId:  "i-0123456789",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, JobState_PENDING, resp.Status)
+		assert.Nil(t, store.Unlock(lockKey))
+
+		j := getJob(resp.Id)
+		assert.Equal(t, Operation_IMPORT, j.Op)
+		assert.Equal(t, `module.app.aws_instance.web["a"]`, j.ImportAddress)
+		assert.Equal(t, "i-0123456789", j.ImportId)
+	})
+
+	t.Run("Should raise validation error when importing without an address or id", func(t *testing.T) {
+		for _, req := range []*ImportResourceRequest{
+			{WorkspaceId: workspaceId, Id: layoutId, ResourceId: "i-0123456789"},
+			{WorkspaceId: workspaceId, Id: layoutId, Address: "aws_instance", ResourceId: "i-0123456789"},
+			{WorkspaceId: workspaceId, Id: layoutId, Address: "aws_instance.web"},
+		} {
+			_, err := server.ImportResource(context.Background(), req)
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), Errors_INVALID_VALUE.String())
+			}
+		}
+	})
+}
+
+// adminCtx makes a context of a request made with a client certificate of the given name.
+func adminCtx(name string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: name}}
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	})
+}
+
+func TestRequireAdmin(t *testing.T) {
+	*admins = []string{"alice"}
+	defer func() { *admins = nil }()
+
+	t.Run("Should allow a client certificate of an admin", func(t *testing.T) {
+		c, err := requireAdmin(adminCtx("alice"))
+		assert.Nil(t, err)
+		assert.Equal(t, "alice", c)
+	})
+
+	t.Run("Should not allow a client certificate of anyone else", func(t *testing.T) {
+		_, err := requireAdmin(adminCtx("mallory"))
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should not allow a request without a client certificate", func(t *testing.T) {
+		_, err := requireAdmin(context.Background())
+		assert.NotNil(t, err)
+	})
+}
+
+func TestServer_GetState(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	state := `{"version": 4, "serial": 3, "outputs": {"password": {"value": "s3cr3t", "type": "string", "sensitive": true}, "vpc_id": {"value": "vpc-1", "type": "string"}}}`
+	assert.Nil(t, store.SaveKey(fmt.Sprintf("state/%s/%s", workspaceId, layoutId), []byte(state)))
+
+	*admins = []string{"alice"}
+	defer func() { *admins = nil }()
+
+	get := func(ctx context.Context) string {
+		resp, err := server.GetState(ctx, &GetStateRequest{WorkspaceId: workspaceId, LayoutId: layoutId})
+		assert.Nil(t, err)
+		return string(resp.State)
+	}
+
+	t.Run("Should mask the sensitive outputs for others than admins", func(t *testing.T) {
+		out := get(adminCtx("mallory"))
+		assert.NotContains(t, out, "s3cr3t")
+		assert.Contains(t, out, "vpc-1")
+		assert.Contains(t, out, `"serial":3`)
+	})
+
+	t.Run("Should get the state as it is for admins, and audit it", func(t *testing.T) {
+		assert.Equal(t, state, get(adminCtx("alice")))
+
+		events, err := server.ListAuditEvents(adminCtx("alice"), &GetWorkspaceRequest{Id: workspaceId})
+		assert.Nil(t, err)
+		if assert.Equal(t, 1, len(events.Events)) {
+			assert.Equal(t, "GetState", events.Events[0].Action)
+			assert.Equal(t, "alice", events.Events[0].Actor)
+		}
+	})
+}
+
+func TestServer_StateVersions(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	tree := types.MakeTree(workspaceId, layoutId)
+	lockKey := fmt.Sprintf("%v-%v", workspaceId, layoutId)
+	stateKey := fmt.Sprintf("state/%s/%s", workspaceId, layoutId)
+
+	*admins = []string{"alice"}
+	defer func() { *admins = nil }()
+
+	versions := []*types.StateVersion{}
+	for i, stage := range []StateStage{StateStage_BEFORE_JOB, StateStage_AFTER_JOB} {
+		v := &types.StateVersion{
+			JobId:  "job-1",
+			Stage:  int32(stage),
+			Serial: int64(i + 1),
+			State:  []byte(fmt.Sprintf(`{"version": 4, "serial": %v}`, i+1)),
+		}
+		assert.Nil(t, store.Save(v, tree))
+		versions = append(versions, v)
+	}
+
+	assert.Nil(t, store.SaveKey(stateKey, versions[1].State))
+
+	restore := func(ctx context.Context) (*StateVersion, error) {
+		return server.RestoreState(ctx, &StateVersionRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: versions[0].Id})
+	}
+
+	t.Run("Should list the versions of the state, newest first", func(t *testing.T) {
+		resp, err := server.ListStateVersions(context.Background(), &GetStateRequest{WorkspaceId: workspaceId, LayoutId: layoutId})
+		assert.Nil(t, err)
+		if assert.Equal(t, 2, len(resp.Versions)) {
+			assert.Equal(t, versions[1].Id, resp.Versions[0].Id)
+			assert.Equal(t, StateStage_AFTER_JOB, resp.Versions[0].Stage)
+			assert.Equal(t, int64(2), resp.Versions[0].Serial)
+			assert.Equal(t, "job-1", resp.Versions[1].JobId)
+		}
+	})
+
+	t.Run("Should get a version of the state", func(t *testing.T) {
+		resp, err := server.GetStateVersion(adminCtx("alice"), &StateVersionRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: versions[0].Id})
+		assert.Nil(t, err)
+		assert.Equal(t, versions[0].State, resp.State)
+	})
+
+	t.Run("Should mask the sensitive outputs of a version for others than admins", func(t *testing.T) {
+		lID := fmt.Sprintf("layout-%s", utils.RandString(8))
+		v := &types.StateVersion{State: []byte(`{"version": 4, "outputs": {"password": {"value": "s3cr3t", "sensitive": true}}}`)}
+		assert.Nil(t, store.Save(v, types.MakeTree(workspaceId, lID)))
+
+		resp, err := server.GetStateVersion(adminCtx("mallory"), &StateVersionRequest{WorkspaceId: workspaceId, LayoutId: lID, Id: v.Id})
+		assert.Nil(t, err)
+		assert.NotContains(t, string(resp.State), "s3cr3t")
+		assert.Contains(t, string(resp.State), `"version":4`)
+	})
+
+	t.Run("Should raise an error for a version that doesn't exist", func(t *testing.T) {
+		_, err := server.GetStateVersion(context.Background(), &StateVersionRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: "missing"})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+		}
+	})
+
+	t.Run("Should not restore the state without a client certificate", func(t *testing.T) {
+		_, err := restore(context.Background())
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should not let anyone but an admin restore the state", func(t *testing.T) {
+		_, err := restore(adminCtx("mallory"))
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should not restore the state while the layout is in use", func(t *testing.T) {
+		assert.Nil(t, store.Lock(lockKey, "job-2"))
+		defer store.Unlock(lockKey)
+
+		_, err := restore(adminCtx("alice"))
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_LOCKED.String())
+		}
+	})
+
+	t.Run("Should restore an older state", func(t *testing.T) {
+		v, err := restore(adminCtx("alice"))
+		assert.Nil(t, err)
+		assert.Equal(t, StateStage_RESTORED, v.Stage)
+		assert.Equal(t, versions[0].Id, v.RestoredFrom)
+		assert.Equal(t, "alice", v.RestoredBy)
+		assert.Equal(t, int64(1), v.Serial)
+
+		b, err := store.GetKey(stateKey)
+		assert.Nil(t, err)
+		assert.Equal(t, versions[0].State, b)
+
+		resp, err := server.ListStateVersions(context.Background(), &GetStateRequest{WorkspaceId: workspaceId, LayoutId: layoutId})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(resp.Versions))
+		assert.Equal(t, v.Id, resp.Versions[0].Id)
+
+		// Layout is released after the restore.
+		assert.Nil(t, store.Lock(lockKey, "job-3"))
+		assert.Nil(t, store.Unlock(lockKey))
+	})
+
+	t.Run("Should audit a restore, and reading a version", func(t *testing.T) {
+		resp, err := server.ListAuditEvents(adminCtx("alice"), &GetWorkspaceRequest{Id: workspaceId})
+		assert.Nil(t, err)
+		if assert.Equal(t, 2, len(resp.Events)) {
+			assert.Equal(t, "RestoreState", resp.Events[0].Action)
+			assert.Equal(t, "alice", resp.Events[0].Actor)
+			assert.Equal(t, "GetStateVersion", resp.Events[1].Action)
+		}
+	})
+}
+
+func TestServer_Locks(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	lockKey := fmt.Sprintf("%v-%v", workspaceId, layoutId)
+
+	jobQueue := dispatcher.NewInMemory()
+	dispatcher.Set(jobQueue)
+
+	*admins = []string{"alice"}
+	defer func() { *admins = nil }()
+
+	lBytes, err := ioutil.ReadFile("../runner/testdata/sleep.tf.json")
+	assert.Nil(t, err)
+
+	pBytes, _ := json.Marshal(map[string]json.RawMessage{"sleep.tf.json": uglyJson(lBytes)})
+	_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+	assert.Nil(t, err)
+
+	running, err := server.ApplyLayout(context.Background(), &ApplyLayoutRequest{WorkspaceId: workspaceId, Id: layoutId})
+	assert.Nil(t, err)
+
+	queued, err := server.ApplyLayout(context.Background(), &ApplyLayoutRequest{WorkspaceId: workspaceId, Id: layoutId})
+	assert.Nil(t, err)
+	assert.Equal(t, JobState_QUEUED, queued.Status)
+
+	unlock := func(ctx context.Context) error {
+		_, err := server.ForceUnlock(ctx, &ForceUnlockRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Reason: "worker died"})
+		return err
+	}
+
+	t.Run("Should not let anyone but an admin list the locks", func(t *testing.T) {
+		_, err := server.ListLocks(adminCtx("mallory"), &ListLocksRequest{WorkspaceId: workspaceId})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should list the locks of a workspace", func(t *testing.T) {
+		resp, err := server.ListLocks(adminCtx("alice"), &ListLocksRequest{WorkspaceId: workspaceId})
+		assert.Nil(t, err)
+		if assert.Equal(t, 1, len(resp.Locks)) {
+			l := resp.Locks[0]
+			assert.Equal(t, workspaceId, l.WorkspaceId)
+			assert.Equal(t, layoutId, l.LayoutId)
+			assert.Equal(t, running.Id, l.JobId)
+			assert.NotZero(t, l.LockedAt)
+			assert.True(t, l.AgeSeconds >= 0)
+		}
+	})
+
+	t.Run("Should list the locks of all workspaces", func(t *testing.T) {
+		resp, err := server.ListLocks(adminCtx("alice"), &ListLocksRequest{})
+		assert.Nil(t, err)
+
+		found := false
+		for _, l := range resp.Locks {
+			found = found || (l.WorkspaceId == workspaceId && l.JobId == running.Id)
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("Should not let anyone but an admin force unlock", func(t *testing.T) {
+		err := unlock(adminCtx("mallory"))
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should require a reason to force unlock", func(t *testing.T) {
+		_, err := server.ForceUnlock(adminCtx("alice"), &ForceUnlockRequest{WorkspaceId: workspaceId, LayoutId: layoutId})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_INVALID_VALUE.String())
+		}
+	})
+
+	t.Run("Should force unlock a layout and dispatch the next job", func(t *testing.T) {
+		assert.Nil(t, unlock(adminCtx("alice")))
+
+		j, err := server.GetJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: running.Id})
+		assert.Nil(t, err)
+		assert.Equal(t, JobState_ERROR, j.Status)
+		assert.Contains(t, j.Error, "alice")
+
+		assert.Equal(t, []string{running.Id, queued.Id}, jobQueue.Store)
+
+		holder, err := store.GetLock(lockKey)
+		assert.Nil(t, err)
+		assert.Equal(t, queued.Id, holder)
+	})
+
+	t.Run("Should audit a forced unlock", func(t *testing.T) {
+		resp, err := server.ListAuditEvents(adminCtx("alice"), &GetWorkspaceRequest{Id: workspaceId})
+		assert.Nil(t, err)
+		if assert.Equal(t, 1, len(resp.Events)) {
+			e := resp.Events[0]
+			assert.Equal(t, "ForceUnlock", e.Action)
+			assert.Equal(t, "alice", e.Actor)
+			assert.Equal(t, layoutId, e.LayoutId)
+			assert.Contains(t, e.Detail, running.Id)
+			assert.Contains(t, e.Detail, "worker died")
+		}
+	})
+
+	t.Run("Should not force unlock a layout that is free", func(t *testing.T) {
+		assert.Nil(t, store.Unlock(lockKey))
+
+		err := unlock(adminCtx("alice"))
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+		}
+	})
+}
+
+func TestServer_Queue(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	lockKey := fmt.Sprintf("%v-%v", workspaceId, layoutId)
+
+	jobQueue := dispatcher.NewInMemory()
+	dispatcher.Set(jobQueue)
+
+	lBytes, err := ioutil.ReadFile("../runner/testdata/sleep.tf.json")
+	assert.Nil(t, err)
+
+	pBytes, _ := json.Marshal(map[string]json.RawMessage{"sleep.tf.json": uglyJson(lBytes)})
+	_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+	assert.Nil(t, err)
+
+	ids := []string{}
+	for i := 0; i < 4; i++ {
+		resp, err := server.ApplyLayout(context.Background(), &ApplyLayoutRequest{WorkspaceId: workspaceId, Id: layoutId})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(i), resp.QueuePosition)
+		ids = append(ids, resp.Id)
+	}
+
+	jobState := func(id string) JobState {
+		resp, err := server.GetJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: id})
+		assert.Nil(t, err)
+		return resp.Status
+	}
+
+	t.Run("Should dispatch only the first job", func(t *testing.T) {
+		assert.Equal(t, []string{ids[0]}, jobQueue.Store)
+		assert.Equal(t, JobState_PENDING, jobState(ids[0]))
+		assert.Equal(t, JobState_QUEUED, jobState(ids[1]))
+	})
+
+	t.Run("Should take an aborted job off the queue", func(t *testing.T) {
+		_, err := server.AbortJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: ids[1]})
+		assert.Nil(t, err)
+		assert.Empty(t, jobQueue.Aborted, "Queued job was never dispatched")
+		assert.Equal(t, JobState_ABORTED, jobState(ids[1]))
+
+		resp, err := server.GetJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: ids[2]})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(1), resp.QueuePosition)
+	})
+
+	t.Run("Should neither abort nor dispatch while the queue is being changed", func(t *testing.T) {
+		queueKey := path.Join(workspaceId, types.LAYOUT, layoutId, types.QUEUE)
+		assert.Nil(t, store.Lock(queueKey, "test"))
+
+		_, err := server.AbortJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: ids[3]})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_LOCKED.String())
+		}
+		assert.Equal(t, JobState_QUEUED, jobState(ids[3]))
+
+		assert.Nil(t, store.Unlock(lockKey))
+		server.(*Server).dispatchQueued()
+		assert.Equal(t, []string{ids[0]}, jobQueue.Store)
+
+		assert.Nil(t, store.Unlock(queueKey))
+	})
+
+	t.Run("Should dispatch jobs in order as the layout is released", func(t *testing.T) {
+		assert.Nil(t, store.Unlock(lockKey))
+		server.(*Server).dispatchQueued()
+		assert.Equal(t, []string{ids[0], ids[2]}, jobQueue.Store)
+
+		// Aborting the running job releases the layout for the next one.
+		_, err := server.AbortJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: ids[2]})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{ids[0], ids[2], ids[3]}, jobQueue.Store)
+		assert.Equal(t, JobState_PENDING, jobState(ids[3]))
+	})
+
+	t.Run("Should leave the layout free once the queue is empty", func(t *testing.T) {
+		assert.Nil(t, store.Unlock(lockKey))
+		server.(*Server).dispatchQueued()
+		assert.Equal(t, 3, len(jobQueue.Store))
+		assert.Nil(t, store.Lock(lockKey, "test"))
+		assert.Nil(t, store.Unlock(lockKey))
+	})
+}
+
+func TestServer_DriftSchedule(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	tree := types.MakeTree(workspaceId)
+	lockKey := fmt.Sprintf("%v-%v", workspaceId, layoutId)
+
+	jobQueue := dispatcher.NewInMemory()
+	dispatcher.Set(jobQueue)
+
+	lBytes, err := ioutil.ReadFile("../runner/testdata/sleep.tf.json")
+	assert.Nil(t, err)
+
+	pBytes, _ := json.Marshal(map[string]json.RawMessage{"sleep.tf.json": uglyJson(lBytes)})
+	_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+	assert.Nil(t, err)
+
+	schedule := func(cron string) error {
+		_, err := server.SetDriftSchedule(context.Background(), &DriftScheduleRequest{
+			WorkspaceId: workspaceId,
+			Id:          layoutId,
+			Cron:        cron,
+		})
+		return err
+	}
+
+	getDrift := func() *LayoutDrift {
+		l, err := server.GetLayout(context.Background(), &LayoutRequest{WorkspaceId: workspaceId, Id: layoutId})
+		assert.Nil(t, err)
+		return l.Drift
+	}
+
+	// A check is due a minute after the schedule was set.
+	later := time.Now().Add(2 * time.Minute)
+
+	t.Run("Should have no drift before a schedule is set", func(t *testing.T) {
+		assert.Nil(t, getDrift())
+	})
+
+	t.Run("Should not set an invalid schedule", func(t *testing.T) {
+		err := schedule("every now and then")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), Errors_INVALID_VALUE.String())
+	})
+
+	t.Run("Should not set a schedule for a layout that doesn't exist", func(t *testing.T) {
+		_, err := server.SetDriftSchedule(context.Background(), &DriftScheduleRequest{
+			WorkspaceId: workspaceId,
+			Id:          "missing",
+			Cron:        "* * * * *",
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should set a schedule", func(t *testing.T) {
+		assert.Nil(t, schedule("* * * * *"))
+		assert.Equal(t, "* * * * *", getDrift().Schedule)
+	})
+
+	t.Run("Should not check a layout that was never applied", func(t *testing.T) {
+		assert.Nil(t, server.(*Server).checkDrift(workspaceId, layoutId, later))
+		assert.Empty(t, jobQueue.Store)
+	})
+
+	resp, err := server.ApplyLayout(context.Background(), &ApplyLayoutRequest{WorkspaceId: workspaceId, Id: layoutId})
+	assert.Nil(t, err)
+	assert.Nil(t, store.Unlock(lockKey))
+
+	applied, err := server.(*Server).getJob(workspaceId, layoutId, resp.Id)
+	assert.Nil(t, err)
+
+	applied.Status = int32(JobState_DONE)
+	assert.Nil(t, store.Save(&types.JobHistory{Job: applied}, tree))
+
+	var driftJob *Job
+	t.Run("Should queue a check of what was last applied", func(t *testing.T) {
+		later = later.Add(2 * time.Minute)
+		assert.Nil(t, server.(*Server).checkDrift(workspaceId, layoutId, later))
+		assert.Equal(t, 2, len(jobQueue.Store))
+
+		jobs, err := server.ListJobs(context.Background(), &ListJobsRequest{WorkspaceId: workspaceId, LayoutId: layoutId})
+		assert.Nil(t, err)
+
+		driftJob = jobs.Jobs[0]
+		assert.True(t, driftJob.Drift)
+		assert.Equal(t, false, driftJob.Dry)
+		assert.Equal(t, applied.LayoutVersion, driftJob.LayoutVersion)
+		assert.Equal(t, JobState_PENDING, driftJob.Status)
+	})
+
+	t.Run("Should not check again till the previous check is done", func(t *testing.T) {
+		assert.Nil(t, server.(*Server).checkDrift(workspaceId, layoutId, later.Add(2*time.Minute)))
+		assert.Equal(t, 2, len(jobQueue.Store))
+	})
+
+	t.Run("Should not check before it is due", func(t *testing.T) {
+		assert.Nil(t, store.Unlock(lockKey))
+
+		j, err := server.(*Server).getJob(workspaceId, layoutId, driftJob.Id)
+		assert.Nil(t, err)
+
+		j.Status = int32(JobState_DONE)
+		assert.Nil(t, store.Save(&types.JobHistory{Job: j}, tree))
+
+		assert.Nil(t, server.(*Server).checkDrift(workspaceId, layoutId, later))
+		assert.Equal(t, 2, len(jobQueue.Store))
+	})
+
+	t.Run("Should keep no history of the schedule", func(t *testing.T) {
+		v, err := store.GetVersions(&types.DriftSchedule{}, types.MakeTree(workspaceId, layoutId))
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"latest"}, v)
+	})
+
+	t.Run("Should get the latest drift of the layout", func(t *testing.T) {
+		d := types.Drift{JobId: driftJob.Id, Drifted: true, Resources: []string{"null_resource.sleep"}}
+		assert.Nil(t, store.Save(&d, types.MakeTree(workspaceId, layoutId)))
+
+		drift := getDrift()
+		assert.Equal(t, driftJob.Id, drift.JobId)
+		assert.True(t, drift.Drifted)
+		assert.Equal(t, []string{"null_resource.sleep"}, drift.Resources)
+	})
+
+	t.Run("Should stop checking once the schedule is unset", func(t *testing.T) {
+		assert.Nil(t, schedule(""))
+		assert.Nil(t, server.(*Server).checkDrift(workspaceId, layoutId, later.Add(time.Hour)))
+		assert.Equal(t, 2, len(jobQueue.Store))
+		assert.Empty(t, getDrift().Schedule)
+	})
+}
+
+func TestServer_DeleteLayoutAndWorkspace(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	tree := types.MakeTree(workspaceId)
+	lockKey := fmt.Sprintf("%v-%v", workspaceId, layoutId)
+	stateKey := fmt.Sprintf("state/%s/%s", workspaceId, layoutId)
+
+	jobQueue := dispatcher.NewInMemory()
+	dispatcher.Set(jobQueue)
+
+	_, err := server.SaveWorkspace(context.Background(), &SaveWorkspaceRequest{Id: workspaceId})
+	assert.Nil(t, err)
+
+	lBytes, err := ioutil.ReadFile("../runner/testdata/sleep.tf.json")
+	assert.Nil(t, err)
+
+	pBytes, _ := json.Marshal(map[string]json.RawMessage{"sleep.tf.json": uglyJson(lBytes)})
+	_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+	assert.Nil(t, err)
+
+	assert.Nil(t, store.SaveKey(stateKey, []byte(`{"version": 4, "resources": [{"type": "null_resource"}]}`)))
+
+	deleteLayout := func(force bool) error {
+		_, err := server.DeleteLayout(context.Background(), &DeleteLayoutRequest{WorkspaceId: workspaceId, Id: layoutId, Force: force})
+		return err
+	}
+
+	deleteWorkspace := func() error {
+		_, err := server.DeleteWorkspace(context.Background(), &DeleteWorkspaceRequest{Id: workspaceId})
+		return err
+	}
+
+	t.Run("Should not delete a workspace that has layouts", func(t *testing.T) {
+		err := deleteWorkspace()
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should not delete a layout whose state has resources", func(t *testing.T) {
+		err := deleteLayout(false)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should not delete a layout that is busy, even with force", func(t *testing.T) {
+		_, err := server.DestroyLayout(context.Background(), &DestroyLayoutRequest{WorkspaceId: workspaceId, Id: layoutId})
+		assert.Nil(t, err)
+
+		err = deleteLayout(true)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_LOCKED.String())
+		}
+
+		assert.Nil(t, store.Unlock(lockKey))
+	})
+
+	t.Run("Should release the lock of a layout it refuses to delete", func(t *testing.T) {
+		assert.NotNil(t, deleteLayout(false))
+
+		holder, err := store.GetLock(lockKey)
+		assert.Nil(t, err)
+		assert.Empty(t, holder)
+	})
+
+	t.Run("Should not delete a layout that was destroyed only in part", func(t *testing.T) {
+		j := types.Job{LayoutId: layoutId, Op: int32(Operation_DESTROY), Status: int32(JobState_DONE), Targets: []string{"null_resource.a"}}
+		assert.Nil(t, store.Save(&j, tree))
+
+		err := deleteLayout(false)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should delete a layout once it is destroyed", func(t *testing.T) {
+		j := types.Job{LayoutId: layoutId, Op: int32(Operation_DESTROY), Status: int32(JobState_DONE)}
+		assert.Nil(t, store.Save(&j, tree))
+
+		assert.Nil(t, deleteLayout(false))
+
+		_, err := server.GetLayout(context.Background(), &LayoutRequest{WorkspaceId: workspaceId, Id: layoutId})
+		assert.NotNil(t, err)
+
+		jobs, err := server.ListJobs(context.Background(), &ListJobsRequest{WorkspaceId: workspaceId, LayoutId: layoutId})
+		assert.Nil(t, err)
+		assert.Empty(t, jobs.Jobs)
+
+		state, err := store.GetKey(stateKey)
+		assert.Nil(t, err)
+		assert.Empty(t, state)
+
+		holder, err := store.GetLock(lockKey)
+		assert.Nil(t, err)
+		assert.Empty(t, holder)
+	})
+
+	t.Run("Should delete a layout with resources when forced", func(t *testing.T) {
+		_, err := server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+		assert.Nil(t, err)
+
+		assert.Nil(t, store.SaveKey(stateKey, []byte(`{"version": 4, "resources": [{"type": "null_resource"}]}`)))
+		assert.Nil(t, deleteLayout(true))
+	})
+
+	t.Run("Should not delete a layout that does not exist", func(t *testing.T) {
+		err := deleteLayout(true)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+		}
+	})
+
+	t.Run("Should delete a workspace without layouts", func(t *testing.T) {
+		assert.Nil(t, deleteWorkspace())
+
+		_, err := server.GetWorkspace(context.Background(), &GetWorkspaceRequest{Id: workspaceId})
+		assert.NotNil(t, err)
+
+		ids, err := server.(*Server).workspaceIDs()
+		assert.Nil(t, err)
+		assert.NotContains(t, ids, workspaceId)
+	})
+
+	t.Run("Should keep the audit events of a deleted workspace", func(t *testing.T) {
+		*admins = []string{"alice"}
+		defer func() { *admins = nil }()
+
+		events, err := server.ListAuditEvents(adminCtx("alice"), &GetWorkspaceRequest{Id: workspaceId})
+		assert.Nil(t, err)
+		if assert.Equal(t, 2, len(events.Events)) {
+			assert.Equal(t, "DeleteWorkspace", events.Events[0].Action)
+			assert.Equal(t, "DeleteLayout", events.Events[1].Action)
+		}
+	})
+}
+
+func TestServer_DiffLayoutVersions(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	tree := types.MakeTree(workspaceId)
+
+	save := func(plan map[string]string) string {
+		p := map[string]json.RawMessage{}
+		for k, v := range plan {
+			p[k] = json.RawMessage(v)
+		}
+
+		pBytes, _ := json.Marshal(p)
+		_, err := server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+		assert.Nil(t, err)
+
+		versions, err := store.GetVersions(&types.Layout{Id: layoutId}, tree)
+		assert.Nil(t, err)
+		return newestFirst(versions)[0]
+	}
+
+	diff := func(from, to string) (*LayoutDiff, error) {
+		return server.DiffLayoutVersions(context.Background(), &DiffLayoutVersionsRequest{
+			WorkspaceId: workspaceId,
+			Id:          layoutId,
+			From:        from,
+			To:          to,
+		})
+	}
+
+	v1 := save(map[string]string{
+		"main.tf.json": `{"resource": {"null_resource": {"a": {"triggers": {"k": "1"}}, "b/c": {}}}}`,
+		"old.tf.json":  `{"variable": {"x": {}}}`,
+	})
+
+	t.Run("Should show every file as added before the layout is applied", func(t *testing.T) {
+		resp, err := diff("", "")
+		assert.Nil(t, err)
+		assert.Empty(t, resp.From)
+		assert.Equal(t, v1, resp.To)
+		if assert.Equal(t, 2, len(resp.Files)) {
+			assert.Equal(t, FileChange_ADDED, resp.Files[0].Change)
+			assert.Equal(t, FileChange_ADDED, resp.Files[1].Change)
+		}
+	})
+
+	applied := types.Job{LayoutId: layoutId, LayoutVersion: v1, Op: int32(Operation_APPLY), Status: int32(JobState_DONE)}
+	assert.Nil(t, store.Save(&applied, tree))
+
+	v2 := save(map[string]string{
+		"main.tf.json": `{"resource": {"null_resource": {"a": {"triggers": {"k": "2"}}, "b/c": {"count": 2}}}}`,
+		"new.tf.json":  `{"output": {"y": {"value": "1"}}}`,
+	})
+
+	t.Run("Should compare the applied version with the latest", func(t *testing.T) {
+		resp, err := diff("", "")
+		assert.Nil(t, err)
+		assert.Equal(t, v1, resp.From)
+		assert.Equal(t, v2, resp.To)
+
+		if assert.Equal(t, 3, len(resp.Files)) {
+			assert.Equal(t, &FileDiff{
+				Name:   "main.tf.json",
+				Change: FileChange_CHANGED,
+				Paths: []string{
+					"/resource/null_resource/a/triggers/k",
+					"/resource/null_resource/b~1c/count",
+				},
+			}, resp.Files[0])
+			assert.Equal(t, &FileDiff{Name: "new.tf.json", Change: FileChange_ADDED}, resp.Files[1])
+			assert.Equal(t, &FileDiff{Name: "old.tf.json", Change: FileChange_REMOVED}, resp.Files[2])
+		}
+	})
+
+	t.Run("Should compare the versions asked for", func(t *testing.T) {
+		resp, err := diff(v2, v1)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(resp.Files))
+		assert.Equal(t, FileChange_REMOVED, resp.Files[1].Change)
+
+		resp, err = diff(v2, v2)
+		assert.Nil(t, err)
+		assert.Empty(t, resp.Files)
+	})
+
+	t.Run("Should not compare a version that does not exist", func(t *testing.T) {
+		_, err := diff("12345", "")
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+		}
+	})
+}
+
+func TestServer_RollbackLayout(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+	tree := types.MakeTree(workspaceId)
+	lockKey := fmt.Sprintf("%v-%v", workspaceId, layoutId)
+
+	jobQueue := dispatcher.NewInMemory()
+	dispatcher.Set(jobQueue)
+
+	lBytes, err := ioutil.ReadFile("../runner/testdata/sleep.tf.json")
+	assert.Nil(t, err)
+
+	layoutVersions := []string{}
+	applies := []*types.Job{}
+	for i := 0; i < 2; i++ {
+		pBytes, _ := json.Marshal(map[string]json.RawMessage{
+			"sleep.tf.json": uglyJson(lBytes),
+			"v.tf.json":     json.RawMessage(fmt.Sprintf(`{"locals": {"v": %d}}`, i)),
+		})
+		_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+		assert.Nil(t, err)
+
+		versions, err := store.GetVersions(&types.Layout{Id: layoutId}, tree)
+		assert.Nil(t, err)
+		layoutVersions = append(layoutVersions, newestFirst(versions)[0])
+
+		j := &types.Job{
+			LayoutId:      layoutId,
+			LayoutVersion: layoutVersions[i],
+			VarsVersion:   fmt.Sprintf("vars-%d", i),
+			Op:            int32(Operation_APPLY),
+			Status:        int32(JobState_DONE),
+		}
+		assert.Nil(t, store.Save(j, tree))
+		applies = append(applies, j)
+	}
+
+	getJob := func(id string) *Job {
+		j, err := server.GetJob(context.Background(), &JobRequest{WorkspaceId: workspaceId, LayoutId: layoutId, Id: id})
+		assert.Nil(t, err)
+		return j
+	}
+
+	t.Run("Should apply a pinned layout version", func(t *testing.T) {
+		req := &ApplyLayoutRequest{WorkspaceId: workspaceId, Id: layoutId, LayoutVersion: layoutVersions[0]}
+		resp, err := server.ApplyLayout(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, layoutVersions[0], getJob(resp.Id).LayoutVersion)
+		assert.Nil(t, store.Unlock(lockKey))
+	})
+
+	t.Run("Should apply the latest layout version by default", func(t *testing.T) {
+		resp, err := server.ApplyLayout(context.Background(), &ApplyLayoutRequest{WorkspaceId: workspaceId, Id: layoutId})
+		assert.Nil(t, err)
+		assert.Equal(t, layoutVersions[1], getJob(resp.Id).LayoutVersion)
+		assert.Nil(t, store.Unlock(lockKey))
+	})
+
+	t.Run("Should not apply a layout version that does not exist", func(t *testing.T) {
+		req := &ApplyLayoutRequest{WorkspaceId: workspaceId, Id: layoutId, LayoutVersion: "12345"}
+		_, err := server.ApplyLayout(context.Background(), req)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+		}
+	})
+
+	t.Run("Should roll back to the apply before the last one", func(t *testing.T) {
+		resp, err := server.RollbackLayout(context.Background(), &RollbackLayoutRequest{WorkspaceId: workspaceId, Id: layoutId})
+		assert.Nil(t, err)
+
+		j := getJob(resp.Id)
+		assert.Equal(t, Operation_APPLY, j.Op)
+		assert.Equal(t, layoutVersions[0], j.LayoutVersion)
+		assert.Equal(t, "vars-0", j.VarsVersion)
+		assert.Nil(t, store.Unlock(lockKey))
+	})
+
+	t.Run("Should roll back to the apply before a chosen job", func(t *testing.T) {
+		resp, err := server.RollbackLayout(context.Background(), &RollbackLayoutRequest{
+			WorkspaceId: workspaceId,
+			Id:          layoutId,
+			JobId:       applies[1].Id,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, layoutVersions[0], getJob(resp.Id).LayoutVersion)
+		assert.Nil(t, store.Unlock(lockKey))
+	})
+
+	t.Run("Should not roll back past the first apply", func(t *testing.T) {
+		_, err := server.RollbackLayout(context.Background(), &RollbackLayoutRequest{
+			WorkspaceId: workspaceId,
+			Id:          layoutId,
+			JobId:       applies[0].Id,
+		})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+		}
+	})
+}
+
+func TestServer_WorkspaceVars(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+
+	_, err := server.SaveWorkspace(context.Background(), &SaveWorkspaceRequest{
+		Id:        workspaceId,
+		Providers: []byte(`{"aws": {"access_key": "a1", "region": "us-east-1"}, "team_a": "x"}`),
+	})
+	assert.Nil(t, err)
+
+	update := func(patch string) (*WorkspaceVarsVersion, error) {
+		return server.UpdateWorkspaceVars(context.Background(), &UpdateWorkspaceVarsRequest{Id: workspaceId, Vars: []byte(patch)})
+	}
+
+	latest := func() map[string]interface{} {
+		v := types.Vars{}
+		assert.Nil(t, store.Get(&v, types.MakeTree(workspaceId)))
+		return v
+	}
+
+	versions := func() []string {
+		resp, err := server.ListWorkspaceVarVersions(context.Background(), &GetWorkspaceRequest{Id: workspaceId})
+		assert.Nil(t, err)
+		return resp.Versions
+	}
+
+	first := versions()[0]
+
+	t.Run("Should merge a patch into the vars", func(t *testing.T) {
+		v, err := update(`{"aws": {"access_key": "a2"}, "team_b": "y"}`)
+		assert.Nil(t, err)
+		assert.Equal(t, v.Id, versions()[0])
+
+		assert.Equal(t, map[string]interface{}{
+			"aws":    map[string]interface{}{"access_key": "a2", "region": "us-east-1"},
+			"team_a": "x",
+			"team_b": "y",
+		}, latest())
+	})
+
+	t.Run("Should delete the keys set to null", func(t *testing.T) {
+		_, err := update(`{"team_a": null}`)
+		assert.Nil(t, err)
+		assert.NotContains(t, latest(), "team_a")
+		assert.Contains(t, latest(), "team_b")
+	})
+
+	t.Run("Should not update the vars with something other than an object", func(t *testing.T) {
+		_, err := update(`["a"]`)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_INVALID_VALUE.String())
+		}
+	})
+
+	t.Run("Should list the versions newest first", func(t *testing.T) {
+		vs := versions()
+		assert.Equal(t, 3, len(vs))
+		assert.Equal(t, first, vs[2])
+	})
+
+	t.Run("Should diff the latest vars with the ones before, redacted", func(t *testing.T) {
+		resp, err := server.DiffWorkspaceVars(context.Background(), &DiffWorkspaceVarsRequest{Id: workspaceId})
+		assert.Nil(t, err)
+		assert.Equal(t, versions()[0], resp.To)
+		assert.Equal(t, versions()[1], resp.From)
+		assert.Equal(t, []string{"/team_a"}, resp.Paths)
+		assert.NotContains(t, string(resp.FromVars), "a2")
+		assert.NotContains(t, string(resp.ToVars), "a2")
+	})
+
+	t.Run("Should diff two versions", func(t *testing.T) {
+		resp, err := server.DiffWorkspaceVars(context.Background(), &DiffWorkspaceVarsRequest{Id: workspaceId, From: first, To: versions()[0]})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"/aws/access_key", "/team_a", "/team_b"}, resp.Paths)
+	})
+
+	t.Run("Should restore an earlier version", func(t *testing.T) {
+		v, err := server.RestoreWorkspaceVars(context.Background(), &RestoreWorkspaceVarsRequest{Id: workspaceId, Version: first})
+		assert.Nil(t, err)
+		assert.Equal(t, v.Id, versions()[0])
+		assert.Equal(t, 4, len(versions()))
+
+		assert.Equal(t, map[string]interface{}{
+			"aws":    map[string]interface{}{"access_key": "a1", "region": "us-east-1"},
+			"team_a": "x",
+		}, latest())
+	})
+
+	t.Run("Should not restore a version that does not exist", func(t *testing.T) {
+		_, err := server.RestoreWorkspaceVars(context.Background(), &RestoreWorkspaceVarsRequest{Id: workspaceId, Version: "12345"})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+		}
+	})
+
+	t.Run("Should not change the vars while they are locked", func(t *testing.T) {
+		key := path.Join(workspaceId, types.VAR)
+		assert.Nil(t, store.Lock(key, "test"))
+
+		_, err := update(`{"team_c": "z"}`)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_LOCKED.String())
+		}
+
+		_, err = server.RestoreWorkspaceVars(context.Background(), &RestoreWorkspaceVarsRequest{Id: workspaceId, Version: first})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_LOCKED.String())
+		}
+
+		_, err = server.SaveWorkspace(context.Background(), &SaveWorkspaceRequest{Id: workspaceId, Providers: []byte(`{"team_c": "z"}`)})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_LOCKED.String())
+		}
+
+		assert.Nil(t, store.Unlock(key))
+		assert.NotContains(t, latest(), "team_c")
+	})
+
+	t.Run("Should take over vars left locked by a server that died", func(t *testing.T) {
+		key := path.Join(workspaceId, types.VAR)
+		at := time.Now().Add(-2 * varsLockTTL).UnixNano()
+		assert.Nil(t, store.Lock(key, fmt.Sprintf("UpdateWorkspaceVars@%v", at)))
+
+		_, err := update(`{"team_d": "w"}`)
+		assert.Nil(t, err)
+		assert.Contains(t, latest(), "team_d")
+
+		v, err := store.GetLock(key)
+		assert.Nil(t, err)
+		assert.Empty(t, v)
+	})
+
+	t.Run("Should not lose concurrent updates", func(t *testing.T) {
+		names := []string{"team_c", "team_d", "team_e"}
+		errs := make([]error, len(names))
+
+		var wg sync.WaitGroup
+		for i, name := range names {
+			wg.Add(1)
+			go func(i int, name string) {
+				defer wg.Done()
+				_, errs[i] = update(fmt.Sprintf(`{%q: "z"}`, name))
+			}(i, name)
+		}
+		wg.Wait()
+
+		vars := latest()
+		for i, name := range names {
+			if errs[i] == nil {
+				assert.Contains(t, vars, name)
+			} else {
+				assert.Contains(t, errs[i].Error(), Errors_LOCKED.String())
+			}
+		}
+	})
+}
+
+func TestServer_Stacks(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	stackId := "env"
+
+	jobQueue := dispatcher.NewInMemory()
+	dispatcher.Set(jobQueue)
+
+	_, err := server.SaveWorkspace(context.Background(), &SaveWorkspaceRequest{Id: workspaceId})
+	assert.Nil(t, err)
+
+	lBytes, err := ioutil.ReadFile("../runner/testdata/sleep.tf.json")
+	assert.Nil(t, err)
+
+	pBytes, _ := json.Marshal(map[string]json.RawMessage{"sleep.tf.json": uglyJson(lBytes)})
+	for _, id := range []string{"network", "db", "cache", "app"} {
+		_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: id, WorkspaceId: workspaceId, Plan: pBytes})
+		assert.Nil(t, err)
+	}
+
+	saveStack := func(layouts ...*StackLayout) error {
+		_, err := server.SaveStack(context.Background(), &SaveStackRequest{WorkspaceId: workspaceId, Id: stackId, Layouts: layouts})
+		return err
+	}
+
+	// finish the Job of a Layout in a run, as its worker would.
+	finish := func(run *StackRun, lID string, st JobState) {
+		for _, sj := range run.Jobs {
+			if sj.LayoutId != lID {
+				continue
+			}
+
+			j, err := server.(*Server).getJob(workspaceId, lID, sj.JobId)
+			assert.Nil(t, err)
+
+			j.Status = int32(st)
+			assert.Nil(t, server.(*Server).saveJob(workspaceId, j))
+			assert.Nil(t, store.Unlock(fmt.Sprintf("%v-%v", workspaceId, lID)))
+		}
+	}
+
+	getRun := func() *StackRun {
+		run, err := server.GetStackRun(context.Background(), &StackRunRequest{WorkspaceId: workspaceId, StackId: stackId})
+		assert.Nil(t, err)
+		return run
+	}
+
+	t.Run("Should not save a stack whose layouts depend on each other", func(t *testing.T) {
+		err := saveStack(
+			&StackLayout{Id: "network", DependsOn: []string{"app"}},
+			&StackLayout{Id: "app", DependsOn: []string{"network"}},
+		)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_INVALID_VALUE.String())
+			assert.Contains(t, err.Error(), "app, network")
+		}
+	})
+
+	t.Run("Should not save a stack of layouts that do not exist", func(t *testing.T) {
+		err := saveStack(&StackLayout{Id: "queue"})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+		}
+	})
+
+	t.Run("Should save a stack and sort it into layers", func(t *testing.T) {
+		assert.Nil(t, saveStack(
+			&StackLayout{Id: "app", DependsOn: []string{"db", "cache"}},
+			&StackLayout{Id: "db", DependsOn: []string{"network"}},
+			&StackLayout{Id: "cache", DependsOn: []string{"network"}},
+			&StackLayout{Id: "network"},
+		))
+
+		st, err := server.GetStack(context.Background(), &StackRequest{WorkspaceId: workspaceId, Id: stackId})
+		assert.Nil(t, err)
+		assert.Equal(t, []*StackLayer{
+			{LayoutIds: []string{"network"}},
+			{LayoutIds: []string{"cache", "db"}},
+			{LayoutIds: []string{"app"}},
+		}, st.Layers)
+		assert.Empty(t, st.LastRunId)
+	})
+
+	t.Run("Should apply a layer at a time, and stop on failure", func(t *testing.T) {
+		run, err := server.ApplyStack(context.Background(), &StackRequest{WorkspaceId: workspaceId, Id: stackId})
+		assert.Nil(t, err)
+		assert.Equal(t, JobState_RUNNING, run.Status)
+		assert.Equal(t, 1, len(run.Jobs))
+		assert.Equal(t, "network", run.Jobs[0].LayoutId)
+		assert.Equal(t, JobState_PENDING, run.Jobs[0].Status)
+
+		_, err = server.ApplyStack(context.Background(), &StackRequest{WorkspaceId: workspaceId, Id: stackId})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_LOCKED.String())
+		}
+
+		// Layer waits till its dependencies are done.
+		server.(*Server).dispatchQueued()
+		assert.Equal(t, 1, len(getRun().Jobs))
+
+		finish(run, "network", JobState_DONE)
+		server.(*Server).dispatchQueued()
+
+		run = getRun()
+		assert.Equal(t, int32(1), run.Layer)
+		assert.Equal(t, 3, len(run.Jobs))
+		assert.Equal(t, JobState_DONE, run.Jobs[0].Status)
+		assert.Equal(t, "cache", run.Jobs[1].LayoutId)
+		assert.Equal(t, "db", run.Jobs[2].LayoutId)
+
+		finish(run, "cache", JobState_DONE)
+		finish(run, "db", JobState_FAILED)
+		server.(*Server).dispatchQueued()
+
+		run = getRun()
+		assert.Equal(t, JobState_FAILED, run.Status)
+		assert.Contains(t, run.Error, "Layout db is FAILED")
+		assert.Equal(t, 3, len(run.Jobs))
+	})
+
+	t.Run("Should destroy in reverse order", func(t *testing.T) {
+		run, err := server.DestroyStack(context.Background(), &StackRequest{WorkspaceId: workspaceId, Id: stackId})
+		assert.Nil(t, err)
+		assert.Equal(t, Operation_DESTROY, run.Op)
+		assert.Equal(t, "app", run.Jobs[0].LayoutId)
+
+		for _, lIDs := range [][]string{{"app"}, {"cache", "db"}, {"network"}} {
+			for _, lID := range lIDs {
+				finish(getRun(), lID, JobState_DONE)
+			}
+			server.(*Server).dispatchQueued()
+		}
+
+		run = getRun()
+		assert.Equal(t, JobState_DONE, run.Status)
+		assert.Equal(t, 4, len(run.Jobs))
+		assert.Equal(t, "network", run.Jobs[3].LayoutId)
+
+		st, err := server.GetStack(context.Background(), &StackRequest{WorkspaceId: workspaceId, Id: stackId})
+		assert.Nil(t, err)
+		assert.Equal(t, run.Id, st.LastRunId)
+	})
+
+	t.Run("Should run each Layout with the vars it was last applied with", func(t *testing.T) {
+		js, err := server.ApplyLayout(context.Background(), &ApplyLayoutRequest{
+			WorkspaceId: workspaceId,
+			Id:          "network",
+			Vars:        []byte(`{"cidr": "10.0.0.0/16"}`),
+		})
+		assert.Nil(t, err)
+
+		applied, err := server.(*Server).getJob(workspaceId, "network", js.Id)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, applied.VarsVersion)
+
+		applied.Status = int32(JobState_DONE)
+		assert.Nil(t, server.(*Server).saveJob(workspaceId, applied))
+		assert.Nil(t, store.Unlock(fmt.Sprintf("%v-%v", workspaceId, "network")))
+
+		run, err := server.ApplyStack(context.Background(), &StackRequest{WorkspaceId: workspaceId, Id: stackId})
+		assert.Nil(t, err)
+		if assert.Equal(t, 1, len(run.Jobs)) {
+			j, err := server.(*Server).getJob(workspaceId, "network", run.Jobs[0].JobId)
+			assert.Nil(t, err)
+			assert.Equal(t, applied.VarsVersion, j.VarsVersion)
+		}
+	})
+
+	t.Run("Should not start or move on a run that is locked", func(t *testing.T) {
+		key := stackLockKey(workspaceId, stackId)
+		assert.Nil(t, store.Lock(key, "elsewhere"))
+
+		_, err := server.ApplyStack(context.Background(), &StackRequest{WorkspaceId: workspaceId, Id: stackId})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "is being run")
+		}
+
+		finish(getRun(), "network", JobState_DONE)
+		server.(*Server).dispatchQueued()
+		assert.Equal(t, int32(0), getRun().Layer)
+
+		assert.Nil(t, store.Unlock(key))
+		server.(*Server).dispatchQueued()
+		assert.Equal(t, int32(1), getRun().Layer)
+	})
+
+	t.Run("Should take over a Stack left locked by a server that died", func(t *testing.T) {
+		key := stackLockKey(workspaceId, stackId)
+		at := time.Now().Add(-2 * stackLockTTL).UnixNano()
+		assert.Nil(t, store.Lock(key, fmt.Sprintf("advance@%v", at)))
+
+		unlock, err := server.(*Server).lockStack(workspaceId, stackId, "run")
+		assert.Nil(t, err)
+		unlock()
+
+		v, err := store.GetLock(key)
+		assert.Nil(t, err)
+		assert.Empty(t, v)
+	})
+}
+
+func TestServer_PromoteLayout(t *testing.T) {
+	staging := fmt.Sprintf("staging-%s", utils.RandString(8))
+	prod := fmt.Sprintf("prod-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+
+	for _, id := range []string{staging, prod} {
+		_, err := server.SaveWorkspace(context.Background(), &SaveWorkspaceRequest{Id: id})
+		assert.Nil(t, err)
+	}
+
+	save := func(plan string) string {
+		pBytes, _ := json.Marshal(map[string]json.RawMessage{"main.tf.json": json.RawMessage(plan)})
+		_, err := server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: staging, Plan: pBytes})
+		assert.Nil(t, err)
+
+		versions, err := store.GetVersions(&types.Layout{Id: layoutId}, types.MakeTree(staging))
+		assert.Nil(t, err)
+		return newestFirst(versions)[0]
+	}
+
+	promote := func(version, target string) (*Promotion, error) {
+		return server.PromoteLayout(context.Background(), &PromoteLayoutRequest{
+			SourceWorkspaceId: staging,
+			Id:                layoutId,
+			Version:           version,
+			TargetWorkspaceId: target,
+		})
+	}
+
+	v1 := save(`{"resource": {"null_resource": {"a": {}}}}`)
+	v2 := save(`{"resource": {"null_resource": {"a": {}, "b": {}}}}`)
+
+	t.Run("Should copy the version asked for into the target workspace", func(t *testing.T) {
+		p, err := promote(v1, prod)
+		assert.Nil(t, err)
+		assert.Equal(t, v1, p.SourceVersion)
+		assert.Equal(t, prod, p.TargetWorkspaceId)
+		assert.NotEmpty(t, p.Version)
+
+		l, err := server.GetLayout(context.Background(), &LayoutRequest{WorkspaceId: prod, Id: layoutId})
+		assert.Nil(t, err)
+		assert.Equal(t, staging, l.SourceWorkspaceId)
+		assert.Equal(t, v1, l.SourceVersion)
+		assert.Equal(t, Status_INACTIVE, l.Status)
+		assert.Nil(t, store.GetVersion(&types.Layout{Id: layoutId}, types.MakeTree(prod), p.Version))
+
+		source := types.Layout{Id: layoutId}
+		assert.Nil(t, store.GetVersion(&source, types.MakeTree(staging), v1))
+		pBytes, _ := json.Marshal(source.Plan)
+		assert.Equal(t, pBytes, l.Plan)
+	})
+
+	t.Run("Should list promotions newest first, and what was applied", func(t *testing.T) {
+		p, err := promote("", prod)
+		assert.Nil(t, err)
+		assert.Equal(t, v2, p.SourceVersion)
+
+		first, err := server.ListPromotions(context.Background(), &LayoutRequest{WorkspaceId: prod, Id: layoutId})
+		assert.Nil(t, err)
+		if assert.Equal(t, 2, len(first.Promotions)) {
+			assert.Equal(t, v1, first.Promotions[1].SourceVersion)
+		}
+
+		applied := types.Job{LayoutId: layoutId, LayoutVersion: first.Promotions[1].Version,
+			Op: int32(Operation_APPLY), Status: int32(JobState_DONE)}
+		assert.Nil(t, store.Save(&applied, types.MakeTree(prod)))
+
+		resp, err := server.ListPromotions(context.Background(), &LayoutRequest{WorkspaceId: prod, Id: layoutId})
+		assert.Nil(t, err)
+		assert.Equal(t, p, resp.Promotions[0])
+		assert.Equal(t, staging, resp.AppliedSourceWorkspaceId)
+		assert.Equal(t, v1, resp.AppliedSourceVersion)
+		assert.Equal(t, v2, resp.LatestSourceVersion)
+	})
+
+	t.Run("Should not promote to the same workspace", func(t *testing.T) {
+		_, err := promote(v1, staging)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should not promote what is not a version", func(t *testing.T) {
+		_, err := promote("../"+v1, prod)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_INVALID_VALUE.String())
+		}
+
+		in := &PromoteLayoutRequest{SourceWorkspaceId: staging, Id: layoutId, Version: "latest", TargetWorkspaceId: prod}
+		assert.Nil(t, in.Validate())
+	})
+
+	t.Run("Should not promote a version or to a workspace that does not exist", func(t *testing.T) {
+		_, err := promote("12345", prod)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_FOUND.String())
+		}
+
+		_, err = promote(v1, "missing-"+utils.RandString(8))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should not promote a plan that conflicts with the target providers", func(t *testing.T) {
+		wv, _ := json.Marshal(types.Vars{"null": nil})
+		_, err := server.SaveWorkspace(context.Background(), &SaveWorkspaceRequest{Id: prod, Providers: wv})
+		assert.Nil(t, err)
+
+		pBytes, _ := json.Marshal(map[string]json.RawMessage{
+			"main.tf.json": json.RawMessage(`{"provider": {"null": {}}}`),
+		})
+		_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: staging, Plan: pBytes})
+		assert.Nil(t, err)
+
+		_, err = promote("", prod)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "Provider conflict")
+		}
+	})
+}
+
+type exportStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data []byte
+}
+
+func (s *exportStream) Send(c *ArchiveChunk) error {
+	s.data = append(s.data, c.Data...)
+	return nil
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+type importStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*ImportWorkspaceRequest
+	ok   bool
+}
+
+func (s *importStream) Recv() (*ImportWorkspaceRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+
+	r := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return r, nil
+}
+
+func (s *importStream) SendAndClose(*Ok) error {
+	s.ok = true
+	return nil
+}
+
+func (s *importStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestServer_ExportImportWorkspace(t *testing.T) {
+	workspaceId := fmt.Sprintf("workspace-%s", utils.RandString(8))
+	layoutId := fmt.Sprintf("layout-%s", utils.RandString(8))
+
+	// Base64 of 32 bytes.
+	key := "a2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2s="
+
+	wv, _ := json.Marshal(types.Vars{"aws": map[string]interface{}{"secret_key": "s3cr3t"}})
+	_, err := server.SaveWorkspace(context.Background(), &SaveWorkspaceRequest{Id: workspaceId, Providers: wv})
+	assert.Nil(t, err)
+
+	pBytes, _ := json.Marshal(map[string]json.RawMessage{"main.tf.json": json.RawMessage(`{}`)})
+	_, err = server.SaveLayout(context.Background(), &SaveLayoutRequest{Id: layoutId, WorkspaceId: workspaceId, Plan: pBytes})
+	assert.Nil(t, err)
+
+	*admins = []string{"alice"}
+	defer func() { *admins = nil }()
+
+	export := func(key string) []byte {
+		s := &exportStream{ctx: adminCtx("alice")}
+		assert.Nil(t, server.(*Server).ExportWorkspace(&ExportWorkspaceRequest{Id: workspaceId, Key: key}, s))
+		return s.data
+	}
+
+	// importArchive sends the archive in chunks of a few bytes.
+	importArchive := func(ctx context.Context, data []byte, wID, key string) (*importStream, error) {
+		s := &importStream{ctx: ctx, reqs: []*ImportWorkspaceRequest{{WorkspaceId: wID, Key: key}}}
+		for len(data) > 0 {
+			n := 100
+			if n > len(data) {
+				n = len(data)
+			}
+
+			s.reqs = append(s.reqs, &ImportWorkspaceRequest{Data: data[:n]})
+			data = data[n:]
+		}
+
+		return s, server.(*Server).ImportWorkspace(s)
+	}
+
+	t.Run("Should import an export under a new name", func(t *testing.T) {
+		newId := workspaceId + "-copy"
+		s, err := importArchive(adminCtx("alice"), export(key), newId, key)
+		assert.Nil(t, err)
+		assert.True(t, s.ok)
+
+		vars := types.Vars{}
+		assert.Nil(t, store.Get(&vars, types.MakeTree(newId)))
+		assert.Equal(t, "s3cr3t", vars["aws"].(map[string]interface{})["secret_key"])
+
+		l, err := server.GetLayout(context.Background(), &LayoutRequest{WorkspaceId: newId, Id: layoutId})
+		assert.Nil(t, err)
+		assert.Equal(t, pBytes, l.Plan)
+	})
+
+	t.Run("Should not import into a workspace that exists", func(t *testing.T) {
+		_, err := importArchive(adminCtx("alice"), export(key), "", key)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should not import an archive with redacted secrets", func(t *testing.T) {
+		_, err := importArchive(adminCtx("alice"), export(""), workspaceId+"-redacted", key)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), Errors_NOT_ALLOWED.String())
+		}
+	})
+
+	t.Run("Should be allowed to admins only", func(t *testing.T) {
+		err := server.(*Server).ExportWorkspace(&ExportWorkspaceRequest{Id: workspaceId},
+			&exportStream{ctx: adminCtx("mallory")})
+		assert.NotNil(t, err)
+
+		_, err = importArchive(adminCtx("mallory"), export(key), workspaceId+"-other", key)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestJobStates(t *testing.T) {
+	t.Run("Should be the same as the ones the worker saves", func(t *testing.T) {
+		assert.Equal(t, int32(JobState_PENDING), types.JobPending)
+		assert.Equal(t, int32(JobState_RUNNING), types.JobRunning)
+		assert.Equal(t, int32(JobState_FAILED), types.JobFailed)
+		assert.Equal(t, int32(JobState_ABORTED), types.JobAborted)
+		assert.Equal(t, int32(JobState_DONE), types.JobDone)
+		assert.Equal(t, int32(JobState_ERROR), types.JobError)
+		assert.Equal(t, int32(JobState_QUEUED), types.JobQueued)
+
+		assert.Equal(t, int32(StateStage_BEFORE_JOB), types.StageBeforeJob)
+		assert.Equal(t, int32(StateStage_AFTER_JOB), types.StageAfterJob)
+		assert.Equal(t, int32(StateStage_RESTORED), types.StageRestored)
+	})
+}
@@ -79,4 +79,15 @@ func TestInterceptor(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, resp, &Ok{})
 	})
+
+	t.Run("Should raise an error for non supported lower versions on streams.", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "version", "0.0.1")
+
+		stream, err := tClient.StreamJobLogs(ctx, &JobRequest{Id: "j", WorkspaceId: "w", LayoutId: "l"})
+		assert.Nil(t, err)
+
+		_, err = stream.Recv()
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "older version")
+	})
 }
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/meson10/highbrow"
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// ListLocks held on Layouts, of a Workspace or of all of them. Allowed to admins only.
+func (s *Server) ListLocks(ctx context.Context, in *ListLocksRequest) (*LayoutLocks, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	wIDs := []string{in.WorkspaceId}
+	if in.WorkspaceId == "" {
+		var err error
+		if wIDs, err = s.workspaceIDs(); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	out := &LayoutLocks{Locks: []*LayoutLock{}}
+
+	for _, wID := range wIDs {
+		lIDs, err := s.layoutIDs(wID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lID := range lIDs {
+			l, err := s.getLock(wID, lID)
+			if err != nil {
+				return nil, err
+			}
+
+			if l == nil {
+				continue
+			}
+
+			if l.LockedAt != 0 {
+				l.AgeSeconds = int64(now.Sub(time.Unix(0, l.LockedAt)).Seconds())
+			}
+
+			out.Locks = append(out.Locks, l)
+		}
+	}
+
+	return out, nil
+}
+
+// getLock held on a Layout, nil if it is free.
+// Locks taken before their time was recorded have no LockedAt.
+func (s *Server) getLock(wID, lID string) (*LayoutLock, error) {
+	holder, err := s.store.GetLock(lockKey(wID, lID))
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot get lock")
+	}
+
+	if holder == "" {
+		return nil, nil
+	}
+
+	l := &LayoutLock{WorkspaceId: wID, LayoutId: lID, JobId: holder}
+
+	info := types.LockInfo{}
+	b, err := s.store.GetKey(info.MakePath(types.MakeTree(wID, lID)))
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot get lock info")
+	}
+
+	if len(b) > 0 && info.Unmarshal(b) == nil && info.Holder == holder {
+		l.LockedAt = info.LockedAt
+	}
+
+	return l, nil
+}
+
+// ForceUnlock a Layout, for when the Job holding it is gone without releasing it.
+// The Job is marked ERROR unless it had finished, and the next queued Job is dispatched.
+// Allowed to admins only, and audited.
+func (s *Server) ForceUnlock(ctx context.Context, in *ForceUnlockRequest) (*Ok, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	admin, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := s.getLock(in.WorkspaceId, in.LayoutId)
+	if err != nil {
+		return nil, err
+	}
+
+	if l == nil {
+		return nil, errors.Errorf("%v: Layout %v is not locked", Errors_NOT_FOUND, in.LayoutId)
+	}
+
+	// Only the holder that was read is released, the Lock may have changed hands since.
+	if err := highbrow.Try(saveRetry, func() error {
+		return s.store.UnlockIf(lockKey(in.WorkspaceId, in.LayoutId), l.JobId)
+	}); err != nil {
+		return nil, errors.Wrapf(err, "%v: Cannot unlock", Errors_LOCKED)
+	}
+
+	s.audit(in.WorkspaceId, &types.AuditEvent{
+		Action:   "ForceUnlock",
+		Actor:    admin,
+		LayoutId: in.LayoutId,
+		Detail:   fmt.Sprintf("Released lock held by %v: %v", l.JobId, in.Reason),
+	})
+
+	// Holder may not be a Job at all, like a restore of the state.
+	if j, err := s.getJob(in.WorkspaceId, in.LayoutId, l.JobId); err == nil && !finished(JobState(j.Status)) {
+		j.Status = int32(JobState_ERROR)
+		j.Error = fmt.Sprintf("Lock was released by %v: %v", admin, in.Reason)
+		if err := s.saveJob(in.WorkspaceId, j); err != nil {
+			log.Printf("Cannot save job %v: %+v", j.Id, err)
+		}
+	}
+
+	if _, _, err := s.dispatchNext(in.WorkspaceId, in.LayoutId); err != nil {
+		return nil, err
+	}
+
+	return &Ok{}, nil
+}
+
+// lockFor takes a short lived lock on key for holder, in as many tries, and returns what
+// unlocks it. The time it was taken is kept with the holder, so that a lock older than ttl,
+// left behind by a server that died holding it, is taken over instead of blocking for good.
+func (s *Server) lockFor(key, holder string, ttl time.Duration, tries int) (func(), error) {
+	value := fmt.Sprintf("%v@%v", holder, time.Now().UnixNano())
+	if err := highbrow.Try(tries, func() error {
+		err := s.store.Lock(key, value)
+		if err != nil && s.releaseStale(key, ttl) {
+			err = s.store.Lock(key, value)
+		}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		if err := s.store.UnlockIf(key, value); err != nil {
+			log.Printf("Cannot unlock %v: %+v", key, err)
+		}
+	}, nil
+}
+
+// releaseStale lock on key, if it was taken by lockFor longer than ttl ago.
+// Returns whether it was released.
+func (s *Server) releaseStale(key string, ttl time.Duration) bool {
+	value, err := s.store.GetLock(key)
+	if err != nil {
+		log.Printf("Cannot get lock %v: %+v", key, err)
+		return false
+	}
+
+	i := strings.LastIndex(value, "@")
+	if i < 0 {
+		return false
+	}
+
+	at, err := strconv.ParseInt(value[i+1:], 10, 64)
+	if err != nil || time.Since(time.Unix(0, at)) < ttl {
+		return false
+	}
+
+	if err := s.store.UnlockIf(key, value); err != nil {
+		log.Printf("Cannot release stale lock %v: %+v", key, err)
+		return false
+	}
+
+	log.Printf("Released stale lock %v held by %v", key, value[:i])
+	return true
+}
@@ -34,7 +34,12 @@ func getTotpPayload(ctx context.Context, op string) (*ts2fa.Payload, error) {
 	return obj, nil
 }
 
-func TwoFAInterceptor(c io.ReadCloser, validator func(string, string) bool) grpc.UnaryServerInterceptor {
+// twoFA verifies the TOTP codes that calls carry, by the rules of a config.
+type twoFA struct {
+	tfa *ts2fa.Ts2FA
+}
+
+func newTwoFA(c io.ReadCloser, validator func(string, string) bool) *twoFA {
 	// check if 2FA codes are valid.
 	// todo: currently using in memory.
 	var config ts2fa.Ts2FAConf
@@ -51,31 +56,58 @@ func TwoFAInterceptor(c io.ReadCloser, validator func(string, string) bool) grpc
 	}
 
 	config.Validator = validator
-	tfa := ts2fa.New(&config)
+	return &twoFA{tfa: ts2fa.New(&config)}
+}
 
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		infoList := strings.Split(info.FullMethod, "/")
+// verify the codes in the metadata of a call to a method.
+func (f *twoFA) verify(ctx context.Context, fullMethod string) error {
+	infoList := strings.Split(fullMethod, "/")
+	if len(infoList) <= 1 {
+		return nil
+	}
 
-		if len(infoList) > 1 {
-			obj, err := getTotpPayload(ctx, infoList[len(infoList)-1])
-			if err != nil {
-				log.Println(fmt.Sprintf("Error while fetching 2fa headers: %v", err))
-				return nil, err
-			}
+	obj, err := getTotpPayload(ctx, infoList[len(infoList)-1])
+	if err != nil {
+		log.Println(fmt.Sprintf("Error while fetching 2fa headers: %v", err))
+		return err
+	}
 
-			log.Printf("Validating payload %+v\n", obj)
+	log.Printf("Validating payload %+v\n", obj)
 
-			valid, err := tfa.Verify(obj)
-			if err != nil {
-				return nil, err
-			}
+	valid, err := f.tfa.Verify(obj)
+	if err != nil {
+		return err
+	}
 
-			if !valid {
-				return nil, fmt.Errorf("totp Validation failed")
-			}
+	if !valid {
+		return fmt.Errorf("totp Validation failed")
+	}
+
+	return nil
+}
+
+func TwoFAInterceptor(c io.ReadCloser, validator func(string, string) bool) grpc.UnaryServerInterceptor {
+	f := newTwoFA(c, validator)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := f.verify(ctx, info.FullMethod); err != nil {
+			return nil, err
 		}
 
 		// this operation never expects a 2FA for the object, allow the operation to be performed.
 		return handler(ctx, req)
 	}
 }
+
+// TwoFAStreamInterceptor does the same checks as TwoFAInterceptor, for streaming calls.
+func TwoFAStreamInterceptor(c io.ReadCloser, validator func(string, string) bool) grpc.StreamServerInterceptor {
+	f := newTwoFA(c, validator)
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := f.verify(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
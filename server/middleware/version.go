@@ -31,29 +31,38 @@ func validateVersion(cliVersion, leastVersion string) bool {
 	return c.Match(cliVersion)
 }
 
-func UnaryServerInterceptor(supportVersion string) grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		url := "https://github.com/tsocial/tessellate/releases"
+// checkVersion of the client against the least supported version.
+func checkVersion(ctx context.Context, supportVersion string) error {
+	url := "https://github.com/tsocial/tessellate/releases"
 
-		// Get the version from the header.
-		version, err := getVersionId(ctx)
-		if err != nil {
-			return nil, err
-		}
+	// Get the version from the header.
+	version, err := getVersionId(ctx)
+	if err != nil {
+		return err
+	}
 
-		versionErr := errors.Errorf(
-			"You are using an older version: %v of Tessellate CLI. "+
-				"Download the newer version (>= %v) from: %v",
-			version, supportVersion, url)
+	versionErr := errors.Errorf(
+		"You are using an older version: %v of Tessellate CLI. "+
+			"Download the newer version (>= %v) from: %v",
+		version, supportVersion, url)
 
-		// If the id is empty, return a older version error.
-		if version == "" {
-			log.Printf("Version not found.")
-			return nil, versionErr
-		}
+	// If the id is empty, return a older version error.
+	if version == "" {
+		log.Printf("Version not found.")
+		return versionErr
+	}
+
+	if !validateVersion(version, supportVersion) {
+		return versionErr
+	}
 
-		if !validateVersion(version, supportVersion) {
-			return nil, versionErr
+	return nil
+}
+
+func UnaryServerInterceptor(supportVersion string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkVersion(ctx, supportVersion); err != nil {
+			return nil, err
 		}
 
 		// Else, pass the request ahead to the handler.
@@ -67,3 +76,15 @@ func UnaryServerInterceptor(supportVersion string) grpc.UnaryServerInterceptor {
 		return resp, nil
 	}
 }
+
+// StreamServerInterceptor does the same version check as UnaryServerInterceptor,
+// for streaming calls.
+func StreamServerInterceptor(supportVersion string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkVersion(ss.Context(), supportVersion); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// PromoteLayout copies a version of a Layout, as it is, from one Workspace to another.
+// It becomes the latest version of the Layout in the target Workspace, which records
+// where it came from. The Plan is checked against the providers of the target Workspace,
+// the same as SaveLayout would.
+func (s *Server) PromoteLayout(ctx context.Context, in *PromoteLayoutRequest) (*Promotion, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	if in.SourceWorkspaceId == in.TargetWorkspaceId {
+		return nil, errors.Errorf("%v: Layout %v cannot be promoted to the Workspace it is in",
+			Errors_NOT_ALLOWED, in.Id)
+	}
+
+	version := in.Version
+	if version == "" || version == "latest" {
+		v, err := s.latestLayoutVersion(in.SourceWorkspaceId, in.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		version = v
+	}
+
+	source := types.Layout{Id: in.Id}
+	if err := s.store.GetVersion(&source, types.MakeTree(in.SourceWorkspaceId), version); err != nil {
+		return nil, errors.Wrapf(err, "%v: Layout %v has no version %v", Errors_NOT_FOUND, in.Id, version)
+	}
+
+	tree := types.MakeTree(in.TargetWorkspaceId)
+	target := types.Workspace(in.TargetWorkspaceId)
+	if err := s.store.Get(&target, tree); err != nil {
+		return nil, errors.Wrapf(err, "%v: Workspace %v", Errors_NOT_FOUND, in.TargetWorkspaceId)
+	}
+
+	wVars := &types.Vars{}
+	if err := s.store.Get(wVars, tree); err != nil {
+		log.Printf("Vars not found %+v", err)
+	}
+
+	if err := providerConflict(source.Plan, wVars); err != nil {
+		return nil, errors.Wrap(err, "Provider conflict")
+	}
+
+	layout := types.Layout{
+		Id:              in.Id,
+		Plan:            source.Plan,
+		Status:          int32(Status_INACTIVE),
+		SourceWorkspace: in.SourceWorkspaceId,
+		SourceVersion:   version,
+	}
+
+	// The Promotion is saved ahead of the version it makes, so that there is no version
+	// without a record of where it came from. It is taken back if the version isn't saved.
+	p := types.Promotion{
+		LayoutId:        in.Id,
+		SourceWorkspace: in.SourceWorkspaceId,
+		SourceVersion:   version,
+		Version:         fmt.Sprint(time.Now().UnixNano()),
+		PromotedBy:      caller(ctx),
+	}
+
+	pTree := types.MakeTree(in.TargetWorkspaceId, in.Id)
+	if err := s.store.Save(&p, pTree); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.SaveTag(&layout, tree, p.Version); err != nil {
+		if err := s.store.DeleteKey(path.Join(p.MakePath(pTree), p.Id)); err != nil {
+			log.Printf("Cannot take back promotion %v of %v: %+v", p.Id, in.Id, err)
+		}
+
+		return nil, err
+	}
+
+	return promotionMessage(in.TargetWorkspaceId, &p), nil
+}
+
+// ListPromotions of a Layout into a Workspace, newest first.
+// Along with them comes what was last applied and where it was promoted from, and the
+// latest version in the Workspace it was last promoted from, which tells if the Layout
+// lags behind it.
+func (s *Server) ListPromotions(ctx context.Context, in *LayoutRequest) (*Promotions, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	tree := types.MakeTree(in.WorkspaceId, in.Id)
+	versions, err := s.store.GetVersions(&types.Promotion{}, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &Promotions{Promotions: []*Promotion{}}
+	for _, id := range newestFirst(versions) {
+		p := types.Promotion{}
+		if err := s.store.GetVersion(&p, tree, id); err != nil {
+			return nil, err
+		}
+
+		// The record is marshalled before an Id is assigned to it.
+		p.Id = id
+		out.Promotions = append(out.Promotions, promotionMessage(in.WorkspaceId, &p))
+	}
+
+	j, err := s.lastApplied(in.WorkspaceId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if j != nil && j.LayoutVersion != "" {
+		l := types.Layout{Id: in.Id}
+		if err := s.store.GetVersion(&l, types.MakeTree(in.WorkspaceId), j.LayoutVersion); err != nil {
+			return nil, err
+		}
+
+		out.AppliedVersion = j.LayoutVersion
+		out.AppliedSourceWorkspaceId = l.SourceWorkspace
+		out.AppliedSourceVersion = l.SourceVersion
+	}
+
+	if len(out.Promotions) > 0 {
+		latest, err := s.latestLayoutVersion(out.Promotions[0].SourceWorkspaceId, in.Id)
+		if err != nil && !strings.Contains(err.Error(), Errors_NOT_FOUND.String()) {
+			return nil, err
+		}
+
+		out.LatestSourceVersion = latest
+	}
+
+	return out, nil
+}
+
+// latestLayoutVersion returns the newest version of a Layout in a Workspace.
+func (s *Server) latestLayoutVersion(wID, lID string) (string, error) {
+	versions, err := s.store.GetVersions(&types.Layout{Id: lID}, types.MakeTree(wID))
+	if err != nil {
+		return "", err
+	}
+
+	ids := newestFirst(versions)
+	if len(ids) == 0 {
+		return "", errors.Errorf("%v: Layout %v has no versions in %v", Errors_NOT_FOUND, lID, wID)
+	}
+
+	return ids[0], nil
+}
+
+func promotionMessage(wID string, p *types.Promotion) *Promotion {
+	// Promotions are keyed by the time they were made at, in nanoseconds.
+	at, _ := strconv.ParseInt(p.Id, 10, 64)
+
+	return &Promotion{
+		Id:                p.Id,
+		LayoutId:          p.LayoutId,
+		SourceWorkspaceId: p.SourceWorkspace,
+		SourceVersion:     p.SourceVersion,
+		TargetWorkspaceId: wID,
+		Version:           p.Version,
+		PromotedBy:        p.PromotedBy,
+		PromotedAt:        at / 1e9,
+	}
+}
@@ -0,0 +1,362 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/meson10/highbrow"
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/dispatcher"
+	"github.com/tsocial/tessellate/storage"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// Schedule dispatches the queued Jobs of every Layout, one at a time, as soon as the
+// Layout is free, queues the drift checks that are due and moves running Stacks on.
+// Checks every interval, till ctx is done.
+func Schedule(ctx context.Context, store storage.Storer, interval time.Duration) {
+	s := &Server{store: store}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			s.dispatchQueued()
+		}
+	}
+}
+
+// dispatchQueued looks at the queue, and the drift schedule, of every Layout of every Workspace,
+// and at the Stacks of every Workspace.
+func (s *Server) dispatchQueued() {
+	now := time.Now()
+
+	wIDs, err := s.workspaceIDs()
+	if err != nil {
+		log.Printf("Cannot list workspaces: %+v", err)
+		return
+	}
+
+	for _, wID := range wIDs {
+		lIDs, err := s.layoutIDs(wID)
+		if err != nil {
+			log.Printf("Cannot list layouts of %v: %+v", wID, err)
+			continue
+		}
+
+		for _, lID := range lIDs {
+			if err := s.checkDrift(wID, lID, now); err != nil {
+				log.Printf("Cannot check drift of %v/%v: %+v", wID, lID, err)
+			}
+
+			if _, _, err := s.dispatchNext(wID, lID); err != nil {
+				log.Printf("Cannot dispatch next job of %v/%v: %+v", wID, lID, err)
+			}
+		}
+
+		if err := s.advanceStacks(wID); err != nil {
+			log.Printf("Cannot advance stacks of %v: %+v", wID, err)
+		}
+	}
+}
+
+// workspaceIDs returns the IDs of all the Workspaces.
+func (s *Server) workspaceIDs() ([]string, error) {
+	keys, err := s.store.GetKeys(types.WORKSPACE+"/", "/")
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, k := range keys {
+		splits := strings.Split(k, "/")
+		if len(splits) != 3 {
+			continue
+		}
+
+		ids = append(ids, splits[1])
+	}
+
+	return ids, nil
+}
+
+// layoutIDs returns the IDs of all the Layouts of a Workspace.
+func (s *Server) layoutIDs(wID string) ([]string, error) {
+	keys, err := s.store.GetKeys(filepath.Join(types.WORKSPACE, wID, types.LAYOUT)+"/", "/")
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, k := range keys {
+		splits := strings.Split(k, "/")
+		if len(splits) != 5 {
+			continue
+		}
+
+		ids = append(ids, splits[3])
+	}
+
+	return ids, nil
+}
+
+func lockKey(wID, lID string) string {
+	return fmt.Sprintf("%v-%v", wID, lID)
+}
+
+// lock a Layout for holder, usually a Job ID, and record when it was locked.
+func (s *Server) lock(wID, lID, holder string) error {
+	if err := s.store.Lock(lockKey(wID, lID), holder); err != nil {
+		return err
+	}
+
+	info := types.LockInfo{Holder: holder, LockedAt: time.Now().UnixNano()}
+	tree := types.MakeTree(wID, lID)
+
+	b, err := info.Marshal()
+	if err == nil {
+		err = s.store.SaveKey(info.MakePath(tree), b)
+	}
+
+	if err != nil {
+		log.Printf("Cannot save lock info of %v/%v: %+v", wID, lID, err)
+	}
+
+	return nil
+}
+
+// enqueue a Job to wait for its turn on the Layout.
+func (s *Server) enqueue(wID string, j *types.Job) error {
+	q := types.QueuedJob{Id: j.Id}
+	b, err := q.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return s.store.SaveKey(q.Key(types.MakeTree(wID, j.LayoutId)), b)
+}
+
+// queueLockTTL is how long the queue of a Layout may stay locked, well past what a change
+// of it takes. An older lock was left behind by a server that died holding it.
+const queueLockTTL = time.Minute
+
+// lockQueue of a Layout while Jobs are put in it, or taken out of it, so that a Job is
+// not dispatched and aborted at the same time. Returns what unlocks it.
+func (s *Server) lockQueue(wID, lID string) (func(), error) {
+	unlock, err := s.lockFor(path.Join(wID, types.LAYOUT, lID, types.QUEUE), "queue", queueLockTTL, saveRetry)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%v: Queue of Layout %v is being changed", Errors_LOCKED, lID)
+	}
+
+	return unlock, nil
+}
+
+// admit a new Job of a Layout. It is saved PENDING, holding the Layout's Lock, if the
+// Layout is free and nobody waits for it, or QUEUED behind the others otherwise.
+// Returns whether the Job holds the Lock.
+func (s *Server) admit(wID string, j *types.Job) (bool, error) {
+	lID := j.LayoutId
+
+	unlock, err := s.lockQueue(wID, lID)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	queue, err := s.queued(wID, lID)
+	if err != nil {
+		return false, err
+	}
+
+	// Jobs that are already waiting go first.
+	j.Status = int32(JobState_PENDING)
+	if len(queue) > 0 {
+		j.Status = int32(JobState_QUEUED)
+	}
+
+	// Save this job in workspace tree.
+	if err := s.store.Save(j, types.MakeTree(wID)); err != nil {
+		return false, err
+	}
+
+	if j.Status == int32(JobState_PENDING) {
+		// Lock for workspace and layout.
+		if err := s.lock(wID, lID, j.Id); err == nil {
+			return true, nil
+		}
+
+		// Layout is busy, wait for it to be released.
+		j.Status = int32(JobState_QUEUED)
+		if err := s.saveJob(wID, j); err != nil {
+			return false, err
+		}
+	}
+
+	return false, highbrow.Try(saveRetry, func() error {
+		return s.enqueue(wID, j)
+	})
+}
+
+// abortQueued Job, taking it off the queue before it is saved ABORTED, under the lock
+// of the queue so that dispatchNext cannot take it in between. Returns the Job as it is
+// then, which is not ABORTED if it was taken off the queue for dispatch already.
+func (s *Server) abortQueued(wID, lID, jID string) (*types.Job, error) {
+	unlock, err := s.lockQueue(wID, lID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	j, err := s.getJob(wID, lID, jID)
+	if err != nil || JobState(j.Status) != JobState_QUEUED {
+		return j, err
+	}
+
+	if err := s.dequeue(wID, lID, jID); err != nil {
+		return nil, err
+	}
+
+	j.Status = int32(JobState_ABORTED)
+	return j, s.saveJob(wID, j)
+}
+
+// dequeue removes a Job from the queue of its Layout.
+func (s *Server) dequeue(wID, lID, jID string) error {
+	q := types.QueuedJob{Id: jID}
+	return s.store.DeleteKey(q.Key(types.MakeTree(wID, lID)))
+}
+
+// queued returns the IDs of the Jobs waiting on a Layout, in the order they were queued.
+func (s *Server) queued(wID, lID string) ([]string, error) {
+	versions, err := s.store.GetVersions(&types.QueuedJob{}, types.MakeTree(wID, lID))
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list queue")
+	}
+
+	ids := newestFirst(versions)
+	for i, j := 0, len(ids)-1; i < j; i, j = i+1, j-1 {
+		ids[i], ids[j] = ids[j], ids[i]
+	}
+
+	return ids, nil
+}
+
+// queuePosition of a Job, starting at 1. Returns 0 if the Job isn't queued.
+func (s *Server) queuePosition(wID, lID, jID string) (int32, error) {
+	ids, err := s.queued(wID, lID)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, id := range ids {
+		if id == jID {
+			return int32(i + 1), nil
+		}
+	}
+
+	return 0, nil
+}
+
+// dispatchNext Job in the queue of a Layout, if the Layout is free.
+// The Job holds the Layout's Lock till the worker, or an abort, releases it.
+// Returns the Job that was dispatched, nil if none was.
+func (s *Server) dispatchNext(wID, lID string) (*types.Job, string, error) {
+	// Most Layouts have nobody waiting, which needs no lock to find out.
+	ids, err := s.queued(wID, lID)
+	if err != nil || len(ids) == 0 {
+		return nil, "", err
+	}
+
+	j, err := s.takeNext(wID, lID)
+	if err != nil || j == nil {
+		return nil, "", err
+	}
+
+	link, err := s.dispatch(wID, j)
+	return j, link, err
+}
+
+// takeNext Job off the queue of a Layout, saved PENDING and holding the Layout's Lock.
+// Done under the lock of the queue, so that a Job aborted meanwhile is not taken.
+// Returns nil if the Layout is busy, or nobody waits for it.
+func (s *Server) takeNext(wID, lID string) (*types.Job, error) {
+	unlock, err := s.lockQueue(wID, lID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	key := lockKey(wID, lID)
+
+	for {
+		ids, err := s.queued(wID, lID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ids) == 0 {
+			return nil, nil
+		}
+
+		// Layout is busy, the queue waits for it to be released.
+		if err := s.lock(wID, lID, ids[0]); err != nil {
+			return nil, nil
+		}
+
+		// Read once the Layout is locked, an abort may have come in since the queue was.
+		j, err := s.getJob(wID, lID, ids[0])
+		if err != nil {
+			s.store.Unlock(key)
+			return nil, err
+		}
+
+		if err := s.dequeue(wID, lID, j.Id); err != nil {
+			s.store.Unlock(key)
+			return nil, err
+		}
+
+		// Job was aborted while it waited, move on to the next one.
+		if JobState(j.Status) != JobState_QUEUED {
+			s.store.Unlock(key)
+			continue
+		}
+
+		j.Status = int32(JobState_PENDING)
+		if err := s.saveJob(wID, j); err != nil {
+			s.store.Unlock(key)
+			return nil, err
+		}
+
+		return j, nil
+	}
+}
+
+// dispatch a PENDING Job that holds the Lock of its Layout.
+// If it cannot be dispatched, the Job is marked ERROR and the Lock released.
+func (s *Server) dispatch(wID string, j *types.Job) (string, error) {
+	link, err := dispatcher.Get().Dispatch(wID, j)
+	if err == nil {
+		return link, nil
+	}
+
+	j.Status = int32(JobState_ERROR)
+	j.Error = err.Error()
+	if err := s.saveJob(wID, j); err != nil {
+		log.Printf("Cannot save job %v: %+v", j.Id, err)
+	}
+
+	highbrow.Try(saveRetry, func() error {
+		return s.store.Unlock(lockKey(wID, j.LayoutId))
+	})
+
+	return "", errors.Wrap(err, "Cannot dispatch job")
+}
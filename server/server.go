@@ -1,11 +1,20 @@
 package server
 
-import "github.com/tsocial/tessellate/storage"
+import (
+	"github.com/tsocial/tessellate/redact"
+	"github.com/tsocial/tessellate/storage"
+)
 
 type Server struct {
-	store storage.Storer
+	store    storage.Storer
+	redactor *redact.Redactor
 }
 
 func New(store storage.Storer) TessellateServer {
-	return &Server{store: store}
+	return NewWithRedactor(store, redact.Default())
+}
+
+// NewWithRedactor masks the secrets in the vars it returns by the rules of the Redactor.
+func NewWithRedactor(store storage.Storer, r *redact.Redactor) TessellateServer {
+	return &Server{store: store, redactor: r}
 }
@@ -0,0 +1,492 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// SaveStack of Layouts of a Workspace, along with the Layouts each of them depends on.
+// The dependencies must not go round in a circle.
+func (s *Server) SaveStack(ctx context.Context, in *SaveStackRequest) (*Ok, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	st := types.Stack{Id: in.Id}
+	for _, l := range in.Layouts {
+		st.Layouts = append(st.Layouts, types.StackLayout{Id: l.Id, DependsOn: l.DependsOn})
+	}
+
+	if _, err := s.stackLayers(in.WorkspaceId, &st); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Save(&st, types.MakeTree(in.WorkspaceId)); err != nil {
+		return nil, err
+	}
+
+	return &Ok{}, nil
+}
+
+// GetStack along with the layers it is applied in, and its latest run.
+func (s *Server) GetStack(ctx context.Context, in *StackRequest) (*Stack, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	st, err := s.getStack(in.WorkspaceId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := sortStack(st)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &Stack{WorkspaceId: in.WorkspaceId, Id: st.Id, Layers: layerMessages(layers)}
+	for _, l := range st.Layouts {
+		out.Layouts = append(out.Layouts, &StackLayout{Id: l.Id, DependsOn: l.DependsOn})
+	}
+
+	run, err := s.lastStackRun(in.WorkspaceId, st.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if run != nil {
+		out.LastRunId = run.Id
+	}
+
+	return out, nil
+}
+
+// ApplyStack applies the Layouts of a Stack a layer at a time, each Layout after the
+// ones it depends on are applied. The run stops at the first Job that is not DONE.
+func (s *Server) ApplyStack(ctx context.Context, in *StackRequest) (*StackRun, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	return s.runStack(in, Operation_APPLY)
+}
+
+// DestroyStack destroys the Layouts of a Stack in the reverse order they are applied in,
+// each Layout after the ones that depend on it.
+func (s *Server) DestroyStack(ctx context.Context, in *StackRequest) (*StackRun, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	return s.runStack(in, Operation_DESTROY)
+}
+
+// GetStackRun with the Jobs of the layers run so far.
+func (s *Server) GetStackRun(ctx context.Context, in *StackRunRequest) (*StackRun, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	if in.Id == "" {
+		run, err := s.lastStackRun(in.WorkspaceId, in.StackId)
+		if err != nil {
+			return nil, err
+		}
+
+		if run == nil {
+			return nil, errors.Errorf("%v: Stack %v was never run", Errors_NOT_FOUND, in.StackId)
+		}
+
+		return s.stackRunMessage(in.WorkspaceId, run)
+	}
+
+	run, err := s.getStackRun(in.WorkspaceId, in.StackId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.stackRunMessage(in.WorkspaceId, run)
+}
+
+// runStack dispatches the first layer of a new run of a Stack. The layers after it are
+// dispatched by the scheduler, as the ones before them are done.
+func (s *Server) runStack(in *StackRequest, op Operation) (*StackRun, error) {
+	wID := in.WorkspaceId
+
+	st, err := s.getStack(wID, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := s.stackLayers(wID, st)
+	if err != nil {
+		return nil, err
+	}
+
+	if op == Operation_DESTROY {
+		for i, j := 0, len(layers)-1; i < j; i, j = i+1, j-1 {
+			layers[i], layers[j] = layers[j], layers[i]
+		}
+	}
+
+	unlock, err := s.lockStack(wID, st.Id, "run")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	last, err := s.lastStackRun(wID, st.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if last != nil && JobState(last.Status) == JobState_RUNNING {
+		return nil, errors.Errorf("%v: Stack %v is still running %v", Errors_LOCKED, st.Id, last.Id)
+	}
+
+	run := &types.StackRun{
+		StackId: st.Id,
+		Op:      int32(op),
+		Status:  int32(JobState_RUNNING),
+		Retry:   in.Retry,
+		Layers:  layers,
+	}
+
+	if err := s.store.Save(run, types.MakeTree(wID)); err != nil {
+		return nil, err
+	}
+
+	if err := s.startLayer(wID, run); err != nil {
+		return nil, err
+	}
+
+	return s.stackRunMessage(wID, run)
+}
+
+// startLayer dispatches a Job for every Layout of the current layer of a run.
+// Each Job runs with the vars the Layout was last applied with, if it ever was.
+// The run fails if a Job cannot be made, Jobs of the layer made till then carry on.
+func (s *Server) startLayer(wID string, run *types.StackRun) error {
+	if run.Jobs == nil {
+		run.Jobs = map[string]string{}
+	}
+
+	for _, lID := range run.Layers[run.Layer] {
+		j := &types.Job{LayoutId: lID, Op: run.Op, Retry: run.Retry}
+
+		applied, err := s.lastApplied(wID, lID)
+		if err != nil {
+			run.Status = int32(JobState_FAILED)
+			run.Error = fmt.Sprintf("Cannot run Layout %v: %v", lID, err)
+			break
+		}
+
+		if applied != nil {
+			j.VarsVersion = applied.VarsVersion
+		}
+
+		js, err := s.opLayout(wID, j, nil)
+		if js != nil {
+			run.Jobs[lID] = js.Id
+		}
+
+		if err != nil {
+			run.Status = int32(JobState_FAILED)
+			run.Error = fmt.Sprintf("Cannot run Layout %v: %v", lID, err)
+			break
+		}
+	}
+
+	return s.saveStackRun(wID, run)
+}
+
+// advanceStack moves a running run on to its next layer once every Job of its current
+// layer is DONE, and stops it as soon as one finishes otherwise.
+func (s *Server) advanceStack(wID string, run *types.StackRun) error {
+	if JobState(run.Status) != JobState_RUNNING {
+		return nil
+	}
+
+	done := true
+	for _, lID := range run.Layers[run.Layer] {
+		j, err := s.getJob(wID, lID, run.Jobs[lID])
+		if err != nil {
+			return err
+		}
+
+		switch st := JobState(j.Status); {
+		case st == JobState_DONE:
+		case finished(st):
+			run.Status = int32(JobState_FAILED)
+			run.Error = fmt.Sprintf("Job %v of Layout %v is %v", j.Id, lID, st)
+			return s.saveStackRun(wID, run)
+		default:
+			done = false
+		}
+	}
+
+	if !done {
+		return nil
+	}
+
+	if int(run.Layer) == len(run.Layers)-1 {
+		run.Status = int32(JobState_DONE)
+		return s.saveStackRun(wID, run)
+	}
+
+	run.Layer++
+	return s.startLayer(wID, run)
+}
+
+// advanceStacks of a Workspace whose latest run is still running.
+func (s *Server) advanceStacks(wID string) error {
+	ids, err := s.stackIDs(wID)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := s.advanceStackRun(wID, id); err != nil {
+			return errors.Wrapf(err, "Cannot advance Stack %v", id)
+		}
+	}
+
+	return nil
+}
+
+// advanceStackRun moves the latest run of a Stack on, unless it is being started, or
+// moved on, elsewhere already.
+func (s *Server) advanceStackRun(wID, id string) error {
+	unlock, err := s.lockStack(wID, id, "advance")
+	if err != nil {
+		return nil
+	}
+	defer unlock()
+
+	run, err := s.lastStackRun(wID, id)
+	if err != nil || run == nil {
+		return err
+	}
+
+	return s.advanceStack(wID, run)
+}
+
+// stackLockKey is apart from the keys of Layout locks, which a Layout of any name could
+// run into.
+func stackLockKey(wID, id string) string {
+	return path.Join(wID, types.STACK, id)
+}
+
+// stackLockTTL is how long a Stack may stay locked, well past what starting a layer takes.
+// An older lock was left behind by a server that died holding it.
+const stackLockTTL = time.Minute
+
+// lockStack for holder while a run of it is started, or moved on, so that two calls, or
+// two servers, do not dispatch the same layer twice. Returns what unlocks it.
+func (s *Server) lockStack(wID, id, holder string) (func(), error) {
+	unlock, err := s.lockFor(stackLockKey(wID, id), holder, stackLockTTL, 1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%v: Stack %v is being run", Errors_LOCKED, id)
+	}
+
+	return unlock, nil
+}
+
+// stackIDs returns the IDs of all the Stacks of a Workspace.
+func (s *Server) stackIDs(wID string) ([]string, error) {
+	keys, err := s.store.GetKeys(filepath.Join(types.WORKSPACE, wID, types.STACK)+"/", "/")
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, k := range keys {
+		splits := strings.Split(k, "/")
+		if len(splits) != 5 {
+			continue
+		}
+
+		ids = append(ids, splits[3])
+	}
+
+	return ids, nil
+}
+
+func (s *Server) getStack(wID, id string) (*types.Stack, error) {
+	st := types.Stack{Id: id}
+	if err := s.store.Get(&st, types.MakeTree(wID)); err != nil {
+		return nil, errors.Wrap(err, Errors_NOT_FOUND.String())
+	}
+
+	return &st, nil
+}
+
+// stackLayers of a Stack whose Layouts all exist in the Workspace.
+func (s *Server) stackLayers(wID string, st *types.Stack) ([][]string, error) {
+	lIDs, err := s.layoutIDs(wID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range st.Layouts {
+		if !hasVersion(lIDs, l.Id) {
+			return nil, errors.Errorf("%v: Layout %v is not in Workspace %v", Errors_NOT_FOUND, l.Id, wID)
+		}
+	}
+
+	return sortStack(st)
+}
+
+// sortStack into layers, each made of the Layouts that only depend on the ones in the
+// layers before it. Fails if a Layout depends on one outside the Stack, or on itself.
+func sortStack(st *types.Stack) ([][]string, error) {
+	deps := map[string]int{}
+	dependents := map[string][]string{}
+
+	for _, l := range st.Layouts {
+		if _, ok := deps[l.Id]; ok {
+			return nil, errors.Errorf("%v: Layout %v is listed more than once", Errors_INVALID_VALUE, l.Id)
+		}
+
+		deps[l.Id] = len(l.DependsOn)
+	}
+
+	for _, l := range st.Layouts {
+		for _, d := range l.DependsOn {
+			if _, ok := deps[d]; !ok {
+				return nil, errors.Errorf("%v: Layout %v depends on %v, which is not in the Stack", Errors_INVALID_VALUE, l.Id, d)
+			}
+
+			dependents[d] = append(dependents[d], l.Id)
+		}
+	}
+
+	layer := []string{}
+	for id, n := range deps {
+		if n == 0 {
+			layer = append(layer, id)
+		}
+	}
+
+	layers := [][]string{}
+	sorted := 0
+	for len(layer) > 0 {
+		sort.Strings(layer)
+		layers = append(layers, layer)
+		sorted += len(layer)
+
+		next := []string{}
+		for _, id := range layer {
+			for _, d := range dependents[id] {
+				if deps[d]--; deps[d] == 0 {
+					next = append(next, d)
+				}
+			}
+		}
+
+		layer = next
+	}
+
+	if sorted < len(deps) {
+		cycle := []string{}
+		for id, n := range deps {
+			if n > 0 {
+				cycle = append(cycle, id)
+			}
+		}
+
+		sort.Strings(cycle)
+		return nil, errors.Errorf("%v: Layouts %v depend on each other", Errors_INVALID_VALUE, strings.Join(cycle, ", "))
+	}
+
+	return layers, nil
+}
+
+// lastStackRun of a Stack, nil if it was never run.
+func (s *Server) lastStackRun(wID, stackID string) (*types.StackRun, error) {
+	versions, err := s.store.GetVersions(&types.StackRun{StackId: stackID}, types.MakeTree(wID))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := newestFirst(versions)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return s.getStackRun(wID, stackID, ids[0])
+}
+
+// getStackRun returns the latest record of a run, like getJob.
+func (s *Server) getStackRun(wID, stackID, id string) (*types.StackRun, error) {
+	tree := types.MakeTree(wID)
+	run := types.StackRun{Id: id, StackId: stackID}
+
+	err := s.store.Get(&types.StackRunHistory{StackRun: &run}, tree)
+	if err == nil {
+		return &run, nil
+	}
+
+	if !strings.Contains(err.Error(), "Missing") {
+		return nil, err
+	}
+
+	if err := s.store.GetVersion(&run, tree, id); err != nil {
+		return nil, errors.Wrap(err, Errors_NOT_FOUND.String())
+	}
+
+	run.Id = id
+	return &run, nil
+}
+
+// saveStackRun saves the run as a new version in its history.
+func (s *Server) saveStackRun(wID string, run *types.StackRun) error {
+	return s.store.Save(&types.StackRunHistory{StackRun: run}, types.MakeTree(wID))
+}
+
+func layerMessages(layers [][]string) []*StackLayer {
+	out := []*StackLayer{}
+	for _, l := range layers {
+		out = append(out, &StackLayer{LayoutIds: l})
+	}
+
+	return out
+}
+
+func (s *Server) stackRunMessage(wID string, run *types.StackRun) (*StackRun, error) {
+	out := &StackRun{
+		Id:      run.Id,
+		StackId: run.StackId,
+		Op:      Operation(run.Op),
+		Status:  JobState(run.Status),
+		Layer:   run.Layer,
+		Layers:  layerMessages(run.Layers),
+		Error:   run.Error,
+	}
+
+	for i, layer := range run.Layers {
+		for _, lID := range layer {
+			jID, ok := run.Jobs[lID]
+			if !ok {
+				continue
+			}
+
+			j, err := s.getJob(wID, lID, jID)
+			if err != nil {
+				return nil, err
+			}
+
+			out.Jobs = append(out.Jobs, &StackJob{LayoutId: lID, JobId: jID, Status: JobState(j.Status), Layer: int32(i)})
+		}
+	}
+
+	return out, nil
+}
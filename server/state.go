@@ -0,0 +1,198 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/meson10/highbrow"
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/redact"
+	"github.com/tsocial/tessellate/runner"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// ListStateVersions of a Layout, newest first.
+// Versions are snapshots of the state taken before and after every Job, and every restore.
+// Only what describes them is listed, the state itself is read with GetStateVersion.
+func (s *Server) ListStateVersions(ctx context.Context, in *GetStateRequest) (*StateVersions, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	tree := types.MakeTree(in.WorkspaceId, in.LayoutId)
+	ids, err := s.store.GetVersions(&types.StateVersion{}, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &StateVersions{Versions: []*StateVersion{}}
+	for _, id := range newestFirst(ids) {
+		v, err := s.getStateVersion(in.WorkspaceId, in.LayoutId, id)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Versions = append(out.Versions, stateVersionMessage(v))
+	}
+
+	return out, nil
+}
+
+// GetStateVersion returns the state as it was saved in a version.
+// Like GetState, only admins get it as it is, and others get it masked.
+func (s *Server) GetStateVersion(ctx context.Context, in *StateVersionRequest) (*GetStateResponse, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	v, err := s.getStateVersion(in.WorkspaceId, in.LayoutId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := requireAdmin(ctx)
+	if err != nil {
+		redacted, err := s.redactState(v.State)
+		if err != nil {
+			return nil, err
+		}
+
+		return &GetStateResponse{State: redacted}, nil
+	}
+
+	s.audit(in.WorkspaceId, &types.AuditEvent{
+		Action:   "GetStateVersion",
+		Actor:    admin,
+		LayoutId: in.LayoutId,
+		Detail:   fmt.Sprintf("Read state version %v", v.Id),
+	})
+
+	return &GetStateResponse{State: v.State}, nil
+}
+
+// RestoreState makes a version of the state the current state of the Layout.
+// The restore is saved as a new version, along with the admin who made it.
+// Not allowed while a Job holds the Layout.
+func (s *Server) RestoreState(ctx context.Context, in *StateVersionRequest) (*StateVersion, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	admin, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	old, err := s.getStateVersion(in.WorkspaceId, in.LayoutId, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	key := lockKey(in.WorkspaceId, in.LayoutId)
+	if err := s.lock(in.WorkspaceId, in.LayoutId, "restore-"+in.Id); err != nil {
+		return nil, errors.Errorf("%v: Layout is in use, %v", Errors_LOCKED, err)
+	}
+
+	v, rErr := s.restoreState(in.WorkspaceId, in.LayoutId, old, admin)
+
+	highbrow.Try(saveRetry, func() error {
+		return s.store.Unlock(key)
+	})
+
+	// Jobs that queued up during the restore. The scheduler retries if this fails.
+	if _, _, err := s.dispatchNext(in.WorkspaceId, in.LayoutId); err != nil {
+		log.Printf("Cannot dispatch next job of %v/%v: %+v", in.WorkspaceId, in.LayoutId, err)
+	}
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	s.audit(in.WorkspaceId, &types.AuditEvent{
+		Action:   "RestoreState",
+		Actor:    admin,
+		LayoutId: in.LayoutId,
+		Detail:   fmt.Sprintf("Restored state version %v as %v", old.Id, v.Id),
+	})
+
+	return stateVersionMessage(v), nil
+}
+
+func (s *Server) restoreState(wID, lID string, old *types.StateVersion, admin string) (*types.StateVersion, error) {
+	if err := s.store.SaveKey(filepath.Join(types.STATE, wID, lID), old.State); err != nil {
+		return nil, errors.Wrap(err, "Cannot restore state")
+	}
+
+	serial, err := runner.StateSerial(old.State)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &types.StateVersion{
+		Stage:        int32(StateStage_RESTORED),
+		Serial:       serial,
+		State:        old.State,
+		CreatedAt:    time.Now().UnixNano(),
+		RestoredFrom: old.Id,
+		RestoredBy:   admin,
+	}
+
+	if err := s.store.Save(v, types.MakeTree(wID, lID)); err != nil {
+		return nil, errors.Wrap(err, "Cannot save state version")
+	}
+
+	return v, nil
+}
+
+func (s *Server) getStateVersion(wID, lID, id string) (*types.StateVersion, error) {
+	v := types.StateVersion{}
+	if err := s.store.GetVersion(&v, types.MakeTree(wID, lID), id); err != nil {
+		return nil, errors.Wrapf(err, "%v: Cannot get state version %v", Errors_NOT_FOUND, id)
+	}
+
+	v.Id = id
+	return &v, nil
+}
+
+func stateVersionMessage(v *types.StateVersion) *StateVersion {
+	return &StateVersion{
+		Id:           v.Id,
+		JobId:        v.JobId,
+		Stage:        StateStage(v.Stage),
+		Serial:       v.Serial,
+		CreatedAt:    v.CreatedAt,
+		RestoredFrom: v.RestoredFrom,
+		RestoredBy:   v.RestoredBy,
+	}
+}
+
+// redactState masks the values of the sensitive outputs of a state, and whatever else
+// in it the redactor takes for a secret, for callers who are not admins.
+func (s *Server) redactState(state []byte) ([]byte, error) {
+	if len(state) == 0 {
+		return state, nil
+	}
+
+	d := json.NewDecoder(bytes.NewReader(state))
+	d.UseNumber()
+
+	v := map[string]interface{}{}
+	if err := d.Decode(&v); err != nil {
+		return nil, errors.Wrap(err, "Cannot read state")
+	}
+
+	if outputs, ok := v["outputs"].(map[string]interface{}); ok {
+		for _, o := range outputs {
+			if m, ok := o.(map[string]interface{}); ok && m["sensitive"] == true {
+				m["value"] = redact.Mask
+			}
+		}
+	}
+
+	return json.Marshal(s.redactor.Value(v))
+}
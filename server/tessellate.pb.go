@@ -95,6 +95,7 @@ const (
 	JobState_ABORTED JobState = 3
 	JobState_DONE    JobState = 4
 	JobState_ERROR   JobState = 5
+	JobState_QUEUED  JobState = 6
 )
 
 var JobState_name = map[int32]string{
@@ -104,6 +105,7 @@ var JobState_name = map[int32]string{
 	3: "ABORTED",
 	4: "DONE",
 	5: "ERROR",
+	6: "QUEUED",
 }
 
 var JobState_value = map[string]int32{
@@ -113,6 +115,7 @@ var JobState_value = map[string]int32{
 	"ABORTED": 3,
 	"DONE":    4,
 	"ERROR":   5,
+	"QUEUED":  6,
 }
 
 func (x JobState) String() string {
@@ -123,21 +126,60 @@ func (JobState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f23e2eaca5ccbb15, []int{2}
 }
 
+type Action int32
+
+const (
+	Action_CREATE  Action = 0
+	Action_UPDATE  Action = 1
+	Action_DELETE  Action = 2
+	Action_REPLACE Action = 3
+)
+
+var Action_name = map[int32]string{
+	0: "CREATE",
+	1: "UPDATE",
+	2: "DELETE",
+	3: "REPLACE",
+}
+
+var Action_value = map[string]int32{
+	"CREATE":  0,
+	"UPDATE":  1,
+	"DELETE":  2,
+	"REPLACE": 3,
+}
+
+func (x Action) String() string {
+	return proto.EnumName(Action_name, int32(x))
+}
+
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{3}
+}
+
 type Operation int32
 
 const (
 	Operation_APPLY   Operation = 0
 	Operation_DESTROY Operation = 1
+	// Update the state to match the infrastructure, without changing either.
+	Operation_REFRESH Operation = 2
+	// Bring an existing resource under a Layout.
+	Operation_IMPORT Operation = 3
 )
 
 var Operation_name = map[int32]string{
 	0: "APPLY",
 	1: "DESTROY",
+	2: "REFRESH",
+	3: "IMPORT",
 }
 
 var Operation_value = map[string]int32{
 	"APPLY":   0,
 	"DESTROY": 1,
+	"REFRESH": 2,
+	"IMPORT":  3,
 }
 
 func (x Operation) String() string {
@@ -145,7 +187,63 @@ func (x Operation) String() string {
 }
 
 func (Operation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{3}
+	return fileDescriptor_f23e2eaca5ccbb15, []int{4}
+}
+
+type FileChange int32
+
+const (
+	FileChange_CHANGED FileChange = 0
+	FileChange_ADDED   FileChange = 1
+	FileChange_REMOVED FileChange = 2
+)
+
+var FileChange_name = map[int32]string{
+	0: "CHANGED",
+	1: "ADDED",
+	2: "REMOVED",
+}
+
+var FileChange_value = map[string]int32{
+	"CHANGED": 0,
+	"ADDED":   1,
+	"REMOVED": 2,
+}
+
+func (x FileChange) String() string {
+	return proto.EnumName(FileChange_name, int32(x))
+}
+
+func (FileChange) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{5}
+}
+
+type StateStage int32
+
+const (
+	StateStage_BEFORE_JOB StateStage = 0
+	StateStage_AFTER_JOB  StateStage = 1
+	StateStage_RESTORED   StateStage = 2
+)
+
+var StateStage_name = map[int32]string{
+	0: "BEFORE_JOB",
+	1: "AFTER_JOB",
+	2: "RESTORED",
+}
+
+var StateStage_value = map[string]int32{
+	"BEFORE_JOB": 0,
+	"AFTER_JOB":  1,
+	"RESTORED":   2,
+}
+
+func (x StateStage) String() string {
+	return proto.EnumName(StateStage_name, int32(x))
+}
+
+func (StateStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{6}
 }
 
 type GetWorkspaceRequest struct {
@@ -250,6 +348,310 @@ func (m *Workspace) GetVersions() []string {
 	return nil
 }
 
+type UpdateWorkspaceVarsRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// JSON Merge Patch (RFC 7396) of the vars. Objects are merged, a null removes a key.
+	Vars                 []byte   `protobuf:"bytes,2,opt,name=Vars,proto3" json:"Vars,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateWorkspaceVarsRequest) Reset()         { *m = UpdateWorkspaceVarsRequest{} }
+func (m *UpdateWorkspaceVarsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateWorkspaceVarsRequest) ProtoMessage()    {}
+func (*UpdateWorkspaceVarsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{2}
+}
+
+func (m *UpdateWorkspaceVarsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateWorkspaceVarsRequest.Unmarshal(m, b)
+}
+func (m *UpdateWorkspaceVarsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateWorkspaceVarsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateWorkspaceVarsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkspaceVarsRequest.Merge(m, src)
+}
+func (m *UpdateWorkspaceVarsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateWorkspaceVarsRequest.Size(m)
+}
+func (m *UpdateWorkspaceVarsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkspaceVarsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkspaceVarsRequest proto.InternalMessageInfo
+
+func (m *UpdateWorkspaceVarsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateWorkspaceVarsRequest) GetVars() []byte {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
+type WorkspaceVarsVersion struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkspaceVarsVersion) Reset()         { *m = WorkspaceVarsVersion{} }
+func (m *WorkspaceVarsVersion) String() string { return proto.CompactTextString(m) }
+func (*WorkspaceVarsVersion) ProtoMessage()    {}
+func (*WorkspaceVarsVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{3}
+}
+
+func (m *WorkspaceVarsVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkspaceVarsVersion.Unmarshal(m, b)
+}
+func (m *WorkspaceVarsVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkspaceVarsVersion.Marshal(b, m, deterministic)
+}
+func (m *WorkspaceVarsVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkspaceVarsVersion.Merge(m, src)
+}
+func (m *WorkspaceVarsVersion) XXX_Size() int {
+	return xxx_messageInfo_WorkspaceVarsVersion.Size(m)
+}
+func (m *WorkspaceVarsVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkspaceVarsVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkspaceVarsVersion proto.InternalMessageInfo
+
+func (m *WorkspaceVarsVersion) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type WorkspaceVarsVersions struct {
+	// Newest first.
+	Versions             []string `protobuf:"bytes,1,rep,name=Versions,proto3" json:"Versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkspaceVarsVersions) Reset()         { *m = WorkspaceVarsVersions{} }
+func (m *WorkspaceVarsVersions) String() string { return proto.CompactTextString(m) }
+func (*WorkspaceVarsVersions) ProtoMessage()    {}
+func (*WorkspaceVarsVersions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{4}
+}
+
+func (m *WorkspaceVarsVersions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkspaceVarsVersions.Unmarshal(m, b)
+}
+func (m *WorkspaceVarsVersions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkspaceVarsVersions.Marshal(b, m, deterministic)
+}
+func (m *WorkspaceVarsVersions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkspaceVarsVersions.Merge(m, src)
+}
+func (m *WorkspaceVarsVersions) XXX_Size() int {
+	return xxx_messageInfo_WorkspaceVarsVersions.Size(m)
+}
+func (m *WorkspaceVarsVersions) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkspaceVarsVersions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkspaceVarsVersions proto.InternalMessageInfo
+
+func (m *WorkspaceVarsVersions) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type DiffWorkspaceVarsRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Defaults to the version before To.
+	From string `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	// Defaults to the latest version.
+	To                   string   `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffWorkspaceVarsRequest) Reset()         { *m = DiffWorkspaceVarsRequest{} }
+func (m *DiffWorkspaceVarsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffWorkspaceVarsRequest) ProtoMessage()    {}
+func (*DiffWorkspaceVarsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{5}
+}
+
+func (m *DiffWorkspaceVarsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffWorkspaceVarsRequest.Unmarshal(m, b)
+}
+func (m *DiffWorkspaceVarsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffWorkspaceVarsRequest.Marshal(b, m, deterministic)
+}
+func (m *DiffWorkspaceVarsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffWorkspaceVarsRequest.Merge(m, src)
+}
+func (m *DiffWorkspaceVarsRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffWorkspaceVarsRequest.Size(m)
+}
+func (m *DiffWorkspaceVarsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffWorkspaceVarsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffWorkspaceVarsRequest proto.InternalMessageInfo
+
+func (m *DiffWorkspaceVarsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DiffWorkspaceVarsRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *DiffWorkspaceVarsRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type WorkspaceVarsDiff struct {
+	From string `protobuf:"bytes,1,opt,name=From,proto3" json:"From,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=To,proto3" json:"To,omitempty"`
+	// JSON Pointers to the vars that differ.
+	Paths []string `protobuf:"bytes,3,rep,name=Paths,proto3" json:"Paths,omitempty"`
+	// Both versions, with secrets redacted.
+	FromVars             []byte   `protobuf:"bytes,4,opt,name=FromVars,proto3" json:"FromVars,omitempty"`
+	ToVars               []byte   `protobuf:"bytes,5,opt,name=ToVars,proto3" json:"ToVars,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkspaceVarsDiff) Reset()         { *m = WorkspaceVarsDiff{} }
+func (m *WorkspaceVarsDiff) String() string { return proto.CompactTextString(m) }
+func (*WorkspaceVarsDiff) ProtoMessage()    {}
+func (*WorkspaceVarsDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{6}
+}
+
+func (m *WorkspaceVarsDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkspaceVarsDiff.Unmarshal(m, b)
+}
+func (m *WorkspaceVarsDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkspaceVarsDiff.Marshal(b, m, deterministic)
+}
+func (m *WorkspaceVarsDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkspaceVarsDiff.Merge(m, src)
+}
+func (m *WorkspaceVarsDiff) XXX_Size() int {
+	return xxx_messageInfo_WorkspaceVarsDiff.Size(m)
+}
+func (m *WorkspaceVarsDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkspaceVarsDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkspaceVarsDiff proto.InternalMessageInfo
+
+func (m *WorkspaceVarsDiff) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *WorkspaceVarsDiff) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *WorkspaceVarsDiff) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *WorkspaceVarsDiff) GetFromVars() []byte {
+	if m != nil {
+		return m.FromVars
+	}
+	return nil
+}
+
+func (m *WorkspaceVarsDiff) GetToVars() []byte {
+	if m != nil {
+		return m.ToVars
+	}
+	return nil
+}
+
+type RestoreWorkspaceVarsRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreWorkspaceVarsRequest) Reset()         { *m = RestoreWorkspaceVarsRequest{} }
+func (m *RestoreWorkspaceVarsRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreWorkspaceVarsRequest) ProtoMessage()    {}
+func (*RestoreWorkspaceVarsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{7}
+}
+
+func (m *RestoreWorkspaceVarsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreWorkspaceVarsRequest.Unmarshal(m, b)
+}
+func (m *RestoreWorkspaceVarsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreWorkspaceVarsRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreWorkspaceVarsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreWorkspaceVarsRequest.Merge(m, src)
+}
+func (m *RestoreWorkspaceVarsRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreWorkspaceVarsRequest.Size(m)
+}
+func (m *RestoreWorkspaceVarsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreWorkspaceVarsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreWorkspaceVarsRequest proto.InternalMessageInfo
+
+func (m *RestoreWorkspaceVarsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RestoreWorkspaceVarsRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 type AllWorkspaces struct {
 	Workspaces           []*Workspace `protobuf:"bytes,1,rep,name=Workspaces,proto3" json:"Workspaces,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -261,7 +663,7 @@ func (m *AllWorkspaces) Reset()         { *m = AllWorkspaces{} }
 func (m *AllWorkspaces) String() string { return proto.CompactTextString(m) }
 func (*AllWorkspaces) ProtoMessage()    {}
 func (*AllWorkspaces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{2}
+	return fileDescriptor_f23e2eaca5ccbb15, []int{8}
 }
 
 func (m *AllWorkspaces) XXX_Unmarshal(b []byte) error {
@@ -300,7 +702,7 @@ func (m *Layouts) Reset()         { *m = Layouts{} }
 func (m *Layouts) String() string { return proto.CompactTextString(m) }
 func (*Layouts) ProtoMessage()    {}
 func (*Layouts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{3}
+	return fileDescriptor_f23e2eaca5ccbb15, []int{9}
 }
 
 func (m *Layouts) XXX_Unmarshal(b []byte) error {
@@ -329,10 +731,14 @@ func (m *Layouts) GetLayouts() []*Layout {
 }
 
 type Layout struct {
-	Workspaceid          string   `protobuf:"bytes,1,opt,name=Workspaceid,proto3" json:"Workspaceid,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
-	Plan                 []byte   `protobuf:"bytes,3,opt,name=Plan,proto3" json:"Plan,omitempty"`
-	Status               Status   `protobuf:"varint,5,opt,name=Status,proto3,enum=tsocial.tessellate.server.Status" json:"Status,omitempty"`
+	Workspaceid string       `protobuf:"bytes,1,opt,name=Workspaceid,proto3" json:"Workspaceid,omitempty"`
+	Id          string       `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Plan        []byte       `protobuf:"bytes,3,opt,name=Plan,proto3" json:"Plan,omitempty"`
+	Status      Status       `protobuf:"varint,5,opt,name=Status,proto3,enum=tsocial.tessellate.server.Status" json:"Status,omitempty"`
+	Drift       *LayoutDrift `protobuf:"bytes,6,opt,name=Drift,proto3" json:"Drift,omitempty"`
+	// Workspace and version of the Layout this one was promoted from, if it was.
+	SourceWorkspaceId    string   `protobuf:"bytes,7,opt,name=SourceWorkspaceId,proto3" json:"SourceWorkspaceId,omitempty"`
+	SourceVersion        string   `protobuf:"bytes,8,opt,name=SourceVersion,proto3" json:"SourceVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -342,7 +748,7 @@ func (m *Layout) Reset()         { *m = Layout{} }
 func (m *Layout) String() string { return proto.CompactTextString(m) }
 func (*Layout) ProtoMessage()    {}
 func (*Layout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{4}
+	return fileDescriptor_f23e2eaca5ccbb15, []int{10}
 }
 
 func (m *Layout) XXX_Unmarshal(b []byte) error {
@@ -391,1349 +797,5077 @@ func (m *Layout) GetStatus() Status {
 	return Status_INACTIVE
 }
 
-type SaveWorkspaceRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Providers            []byte   `protobuf:"bytes,2,opt,name=Providers,proto3" json:"Providers,omitempty"`
+func (m *Layout) GetDrift() *LayoutDrift {
+	if m != nil {
+		return m.Drift
+	}
+	return nil
+}
+
+func (m *Layout) GetSourceWorkspaceId() string {
+	if m != nil {
+		return m.SourceWorkspaceId
+	}
+	return ""
+}
+
+func (m *Layout) GetSourceVersion() string {
+	if m != nil {
+		return m.SourceVersion
+	}
+	return ""
+}
+
+// Latest drift check of a Layout.
+type LayoutDrift struct {
+	Schedule             string   `protobuf:"bytes,1,opt,name=Schedule,proto3" json:"Schedule,omitempty"`
+	JobId                string   `protobuf:"bytes,2,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	Drifted              bool     `protobuf:"varint,3,opt,name=Drifted,proto3" json:"Drifted,omitempty"`
+	Resources            []string `protobuf:"bytes,4,rep,name=Resources,proto3" json:"Resources,omitempty"`
+	CheckedAt            int64    `protobuf:"varint,5,opt,name=CheckedAt,proto3" json:"CheckedAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SaveWorkspaceRequest) Reset()         { *m = SaveWorkspaceRequest{} }
-func (m *SaveWorkspaceRequest) String() string { return proto.CompactTextString(m) }
-func (*SaveWorkspaceRequest) ProtoMessage()    {}
-func (*SaveWorkspaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{5}
+func (m *LayoutDrift) Reset()         { *m = LayoutDrift{} }
+func (m *LayoutDrift) String() string { return proto.CompactTextString(m) }
+func (*LayoutDrift) ProtoMessage()    {}
+func (*LayoutDrift) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{11}
 }
 
-func (m *SaveWorkspaceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SaveWorkspaceRequest.Unmarshal(m, b)
+func (m *LayoutDrift) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LayoutDrift.Unmarshal(m, b)
 }
-func (m *SaveWorkspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SaveWorkspaceRequest.Marshal(b, m, deterministic)
+func (m *LayoutDrift) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LayoutDrift.Marshal(b, m, deterministic)
 }
-func (m *SaveWorkspaceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SaveWorkspaceRequest.Merge(m, src)
+func (m *LayoutDrift) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LayoutDrift.Merge(m, src)
 }
-func (m *SaveWorkspaceRequest) XXX_Size() int {
-	return xxx_messageInfo_SaveWorkspaceRequest.Size(m)
+func (m *LayoutDrift) XXX_Size() int {
+	return xxx_messageInfo_LayoutDrift.Size(m)
 }
-func (m *SaveWorkspaceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SaveWorkspaceRequest.DiscardUnknown(m)
+func (m *LayoutDrift) XXX_DiscardUnknown() {
+	xxx_messageInfo_LayoutDrift.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SaveWorkspaceRequest proto.InternalMessageInfo
+var xxx_messageInfo_LayoutDrift proto.InternalMessageInfo
 
-func (m *SaveWorkspaceRequest) GetId() string {
+func (m *LayoutDrift) GetSchedule() string {
 	if m != nil {
-		return m.Id
+		return m.Schedule
 	}
 	return ""
 }
 
-func (m *SaveWorkspaceRequest) GetProviders() []byte {
+func (m *LayoutDrift) GetJobId() string {
 	if m != nil {
-		return m.Providers
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *LayoutDrift) GetDrifted() bool {
+	if m != nil {
+		return m.Drifted
+	}
+	return false
+}
+
+func (m *LayoutDrift) GetResources() []string {
+	if m != nil {
+		return m.Resources
 	}
 	return nil
 }
 
-type GetWorkspaceLayoutsRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+func (m *LayoutDrift) GetCheckedAt() int64 {
+	if m != nil {
+		return m.CheckedAt
+	}
+	return 0
+}
+
+type DiffLayoutVersionsRequest struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Defaults to the version that was last applied.
+	From string `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`
+	// Defaults to the latest version.
+	To                   string   `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetWorkspaceLayoutsRequest) Reset()         { *m = GetWorkspaceLayoutsRequest{} }
-func (m *GetWorkspaceLayoutsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetWorkspaceLayoutsRequest) ProtoMessage()    {}
-func (*GetWorkspaceLayoutsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{6}
+func (m *DiffLayoutVersionsRequest) Reset()         { *m = DiffLayoutVersionsRequest{} }
+func (m *DiffLayoutVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffLayoutVersionsRequest) ProtoMessage()    {}
+func (*DiffLayoutVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{12}
 }
 
-func (m *GetWorkspaceLayoutsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetWorkspaceLayoutsRequest.Unmarshal(m, b)
+func (m *DiffLayoutVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffLayoutVersionsRequest.Unmarshal(m, b)
 }
-func (m *GetWorkspaceLayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetWorkspaceLayoutsRequest.Marshal(b, m, deterministic)
+func (m *DiffLayoutVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffLayoutVersionsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetWorkspaceLayoutsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetWorkspaceLayoutsRequest.Merge(m, src)
+func (m *DiffLayoutVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffLayoutVersionsRequest.Merge(m, src)
 }
-func (m *GetWorkspaceLayoutsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetWorkspaceLayoutsRequest.Size(m)
+func (m *DiffLayoutVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffLayoutVersionsRequest.Size(m)
 }
-func (m *GetWorkspaceLayoutsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetWorkspaceLayoutsRequest.DiscardUnknown(m)
+func (m *DiffLayoutVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffLayoutVersionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetWorkspaceLayoutsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DiffLayoutVersionsRequest proto.InternalMessageInfo
 
-func (m *GetWorkspaceLayoutsRequest) GetId() string {
+func (m *DiffLayoutVersionsRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *DiffLayoutVersionsRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-type JobStatus struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Status               JobState `protobuf:"varint,2,opt,name=status,proto3,enum=tsocial.tessellate.server.JobState" json:"status,omitempty"`
+func (m *DiffLayoutVersionsRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *DiffLayoutVersionsRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type FileDiff struct {
+	Name   string     `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Change FileChange `protobuf:"varint,2,opt,name=Change,proto3,enum=tsocial.tessellate.server.FileChange" json:"Change,omitempty"`
+	// JSON Pointers to the values that differ in a CHANGED file.
+	Paths                []string `protobuf:"bytes,3,rep,name=Paths,proto3" json:"Paths,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JobStatus) Reset()         { *m = JobStatus{} }
-func (m *JobStatus) String() string { return proto.CompactTextString(m) }
-func (*JobStatus) ProtoMessage()    {}
-func (*JobStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{7}
+func (m *FileDiff) Reset()         { *m = FileDiff{} }
+func (m *FileDiff) String() string { return proto.CompactTextString(m) }
+func (*FileDiff) ProtoMessage()    {}
+func (*FileDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{13}
 }
 
-func (m *JobStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_JobStatus.Unmarshal(m, b)
+func (m *FileDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileDiff.Unmarshal(m, b)
 }
-func (m *JobStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_JobStatus.Marshal(b, m, deterministic)
+func (m *FileDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileDiff.Marshal(b, m, deterministic)
 }
-func (m *JobStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobStatus.Merge(m, src)
+func (m *FileDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileDiff.Merge(m, src)
 }
-func (m *JobStatus) XXX_Size() int {
-	return xxx_messageInfo_JobStatus.Size(m)
+func (m *FileDiff) XXX_Size() int {
+	return xxx_messageInfo_FileDiff.Size(m)
 }
-func (m *JobStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobStatus.DiscardUnknown(m)
+func (m *FileDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileDiff.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobStatus proto.InternalMessageInfo
+var xxx_messageInfo_FileDiff proto.InternalMessageInfo
 
-func (m *JobStatus) GetId() string {
+func (m *FileDiff) GetName() string {
 	if m != nil {
-		return m.Id
+		return m.Name
 	}
 	return ""
 }
 
-func (m *JobStatus) GetStatus() JobState {
+func (m *FileDiff) GetChange() FileChange {
 	if m != nil {
-		return m.Status
+		return m.Change
 	}
-	return JobState_PENDING
+	return FileChange_CHANGED
 }
 
-type Vars struct {
-	Vars                 []byte   `protobuf:"bytes,1,opt,name=Vars,proto3" json:"Vars,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *FileDiff) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
 }
 
-func (m *Vars) Reset()         { *m = Vars{} }
-func (m *Vars) String() string { return proto.CompactTextString(m) }
-func (*Vars) ProtoMessage()    {}
-func (*Vars) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{8}
+type LayoutDiff struct {
+	From                 string      `protobuf:"bytes,1,opt,name=From,proto3" json:"From,omitempty"`
+	To                   string      `protobuf:"bytes,2,opt,name=To,proto3" json:"To,omitempty"`
+	Files                []*FileDiff `protobuf:"bytes,3,rep,name=Files,proto3" json:"Files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Vars) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Vars.Unmarshal(m, b)
+func (m *LayoutDiff) Reset()         { *m = LayoutDiff{} }
+func (m *LayoutDiff) String() string { return proto.CompactTextString(m) }
+func (*LayoutDiff) ProtoMessage()    {}
+func (*LayoutDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{14}
 }
-func (m *Vars) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Vars.Marshal(b, m, deterministic)
+
+func (m *LayoutDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LayoutDiff.Unmarshal(m, b)
 }
-func (m *Vars) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vars.Merge(m, src)
+func (m *LayoutDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LayoutDiff.Marshal(b, m, deterministic)
 }
-func (m *Vars) XXX_Size() int {
-	return xxx_messageInfo_Vars.Size(m)
+func (m *LayoutDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LayoutDiff.Merge(m, src)
 }
-func (m *Vars) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vars.DiscardUnknown(m)
+func (m *LayoutDiff) XXX_Size() int {
+	return xxx_messageInfo_LayoutDiff.Size(m)
+}
+func (m *LayoutDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_LayoutDiff.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Vars proto.InternalMessageInfo
+var xxx_messageInfo_LayoutDiff proto.InternalMessageInfo
 
-func (m *Vars) GetVars() []byte {
+func (m *LayoutDiff) GetFrom() string {
 	if m != nil {
-		return m.Vars
+		return m.From
+	}
+	return ""
+}
+
+func (m *LayoutDiff) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *LayoutDiff) GetFiles() []*FileDiff {
+	if m != nil {
+		return m.Files
 	}
 	return nil
 }
 
-type JobRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+type PromoteLayoutRequest struct {
+	SourceWorkspaceId string `protobuf:"bytes,1,opt,name=SourceWorkspaceId,proto3" json:"SourceWorkspaceId,omitempty"`
+	Id                string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	// A version, or latest, which it defaults to.
+	Version              string   `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	TargetWorkspaceId    string   `protobuf:"bytes,4,opt,name=TargetWorkspaceId,proto3" json:"TargetWorkspaceId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JobRequest) Reset()         { *m = JobRequest{} }
-func (m *JobRequest) String() string { return proto.CompactTextString(m) }
-func (*JobRequest) ProtoMessage()    {}
-func (*JobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{9}
+func (m *PromoteLayoutRequest) Reset()         { *m = PromoteLayoutRequest{} }
+func (m *PromoteLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*PromoteLayoutRequest) ProtoMessage()    {}
+func (*PromoteLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{15}
 }
 
-func (m *JobRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_JobRequest.Unmarshal(m, b)
+func (m *PromoteLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PromoteLayoutRequest.Unmarshal(m, b)
 }
-func (m *JobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_JobRequest.Marshal(b, m, deterministic)
+func (m *PromoteLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PromoteLayoutRequest.Marshal(b, m, deterministic)
 }
-func (m *JobRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRequest.Merge(m, src)
+func (m *PromoteLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteLayoutRequest.Merge(m, src)
 }
-func (m *JobRequest) XXX_Size() int {
-	return xxx_messageInfo_JobRequest.Size(m)
+func (m *PromoteLayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_PromoteLayoutRequest.Size(m)
 }
-func (m *JobRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRequest.DiscardUnknown(m)
+func (m *PromoteLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteLayoutRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobRequest proto.InternalMessageInfo
+var xxx_messageInfo_PromoteLayoutRequest proto.InternalMessageInfo
 
-func (m *JobRequest) GetId() string {
+func (m *PromoteLayoutRequest) GetSourceWorkspaceId() string {
+	if m != nil {
+		return m.SourceWorkspaceId
+	}
+	return ""
+}
+
+func (m *PromoteLayoutRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-type Ok struct {
+func (m *PromoteLayoutRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *PromoteLayoutRequest) GetTargetWorkspaceId() string {
+	if m != nil {
+		return m.TargetWorkspaceId
+	}
+	return ""
+}
+
+type Promotion struct {
+	Id                string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	LayoutId          string `protobuf:"bytes,2,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	SourceWorkspaceId string `protobuf:"bytes,3,opt,name=SourceWorkspaceId,proto3" json:"SourceWorkspaceId,omitempty"`
+	SourceVersion     string `protobuf:"bytes,4,opt,name=SourceVersion,proto3" json:"SourceVersion,omitempty"`
+	TargetWorkspaceId string `protobuf:"bytes,5,opt,name=TargetWorkspaceId,proto3" json:"TargetWorkspaceId,omitempty"`
+	// Version of the Layout made in the target Workspace.
+	Version              string   `protobuf:"bytes,6,opt,name=Version,proto3" json:"Version,omitempty"`
+	PromotedBy           string   `protobuf:"bytes,7,opt,name=PromotedBy,proto3" json:"PromotedBy,omitempty"`
+	PromotedAt           int64    `protobuf:"varint,8,opt,name=PromotedAt,proto3" json:"PromotedAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Ok) Reset()         { *m = Ok{} }
-func (m *Ok) String() string { return proto.CompactTextString(m) }
-func (*Ok) ProtoMessage()    {}
-func (*Ok) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{10}
+func (m *Promotion) Reset()         { *m = Promotion{} }
+func (m *Promotion) String() string { return proto.CompactTextString(m) }
+func (*Promotion) ProtoMessage()    {}
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{16}
 }
 
-func (m *Ok) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Ok.Unmarshal(m, b)
+func (m *Promotion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Promotion.Unmarshal(m, b)
 }
-func (m *Ok) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Ok.Marshal(b, m, deterministic)
+func (m *Promotion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Promotion.Marshal(b, m, deterministic)
 }
-func (m *Ok) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Ok.Merge(m, src)
+func (m *Promotion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Promotion.Merge(m, src)
 }
-func (m *Ok) XXX_Size() int {
-	return xxx_messageInfo_Ok.Size(m)
+func (m *Promotion) XXX_Size() int {
+	return xxx_messageInfo_Promotion.Size(m)
 }
-func (m *Ok) XXX_DiscardUnknown() {
-	xxx_messageInfo_Ok.DiscardUnknown(m)
+func (m *Promotion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Promotion.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Ok proto.InternalMessageInfo
+var xxx_messageInfo_Promotion proto.InternalMessageInfo
 
-type LayoutRequest struct {
-	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Promotion) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *LayoutRequest) Reset()         { *m = LayoutRequest{} }
-func (m *LayoutRequest) String() string { return proto.CompactTextString(m) }
-func (*LayoutRequest) ProtoMessage()    {}
-func (*LayoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{11}
+func (m *Promotion) GetLayoutId() string {
+	if m != nil {
+		return m.LayoutId
+	}
+	return ""
 }
 
-func (m *LayoutRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LayoutRequest.Unmarshal(m, b)
-}
-func (m *LayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LayoutRequest.Marshal(b, m, deterministic)
-}
-func (m *LayoutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LayoutRequest.Merge(m, src)
-}
-func (m *LayoutRequest) XXX_Size() int {
-	return xxx_messageInfo_LayoutRequest.Size(m)
+func (m *Promotion) GetSourceWorkspaceId() string {
+	if m != nil {
+		return m.SourceWorkspaceId
+	}
+	return ""
 }
-func (m *LayoutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LayoutRequest.DiscardUnknown(m)
+
+func (m *Promotion) GetSourceVersion() string {
+	if m != nil {
+		return m.SourceVersion
+	}
+	return ""
 }
 
-var xxx_messageInfo_LayoutRequest proto.InternalMessageInfo
+func (m *Promotion) GetTargetWorkspaceId() string {
+	if m != nil {
+		return m.TargetWorkspaceId
+	}
+	return ""
+}
 
-func (m *LayoutRequest) GetWorkspaceId() string {
+func (m *Promotion) GetVersion() string {
 	if m != nil {
-		return m.WorkspaceId
+		return m.Version
 	}
 	return ""
 }
 
-func (m *LayoutRequest) GetId() string {
+func (m *Promotion) GetPromotedBy() string {
 	if m != nil {
-		return m.Id
+		return m.PromotedBy
 	}
 	return ""
 }
 
-type SaveLayoutRequest struct {
-	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
-	Plan                 []byte   `protobuf:"bytes,3,opt,name=Plan,proto3" json:"Plan,omitempty"`
-	Dry                  bool     `protobuf:"varint,4,opt,name=Dry,proto3" json:"Dry,omitempty"`
+func (m *Promotion) GetPromotedAt() int64 {
+	if m != nil {
+		return m.PromotedAt
+	}
+	return 0
+}
+
+type Promotions struct {
+	// Newest first.
+	Promotions []*Promotion `protobuf:"bytes,1,rep,name=Promotions,proto3" json:"Promotions,omitempty"`
+	// Version of the Layout last applied in the Workspace, and where it was promoted from.
+	AppliedVersion           string `protobuf:"bytes,2,opt,name=AppliedVersion,proto3" json:"AppliedVersion,omitempty"`
+	AppliedSourceWorkspaceId string `protobuf:"bytes,3,opt,name=AppliedSourceWorkspaceId,proto3" json:"AppliedSourceWorkspaceId,omitempty"`
+	AppliedSourceVersion     string `protobuf:"bytes,4,opt,name=AppliedSourceVersion,proto3" json:"AppliedSourceVersion,omitempty"`
+	// Latest version of the Layout in the Workspace of the last promotion.
+	LatestSourceVersion  string   `protobuf:"bytes,5,opt,name=LatestSourceVersion,proto3" json:"LatestSourceVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SaveLayoutRequest) Reset()         { *m = SaveLayoutRequest{} }
-func (m *SaveLayoutRequest) String() string { return proto.CompactTextString(m) }
-func (*SaveLayoutRequest) ProtoMessage()    {}
-func (*SaveLayoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{12}
+func (m *Promotions) Reset()         { *m = Promotions{} }
+func (m *Promotions) String() string { return proto.CompactTextString(m) }
+func (*Promotions) ProtoMessage()    {}
+func (*Promotions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{17}
 }
 
-func (m *SaveLayoutRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SaveLayoutRequest.Unmarshal(m, b)
+func (m *Promotions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Promotions.Unmarshal(m, b)
 }
-func (m *SaveLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SaveLayoutRequest.Marshal(b, m, deterministic)
+func (m *Promotions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Promotions.Marshal(b, m, deterministic)
 }
-func (m *SaveLayoutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SaveLayoutRequest.Merge(m, src)
+func (m *Promotions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Promotions.Merge(m, src)
 }
-func (m *SaveLayoutRequest) XXX_Size() int {
-	return xxx_messageInfo_SaveLayoutRequest.Size(m)
+func (m *Promotions) XXX_Size() int {
+	return xxx_messageInfo_Promotions.Size(m)
 }
-func (m *SaveLayoutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SaveLayoutRequest.DiscardUnknown(m)
+func (m *Promotions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Promotions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SaveLayoutRequest proto.InternalMessageInfo
+var xxx_messageInfo_Promotions proto.InternalMessageInfo
 
-func (m *SaveLayoutRequest) GetWorkspaceId() string {
+func (m *Promotions) GetPromotions() []*Promotion {
 	if m != nil {
-		return m.WorkspaceId
+		return m.Promotions
 	}
-	return ""
+	return nil
 }
 
-func (m *SaveLayoutRequest) GetId() string {
+func (m *Promotions) GetAppliedVersion() string {
 	if m != nil {
-		return m.Id
+		return m.AppliedVersion
 	}
 	return ""
 }
 
-func (m *SaveLayoutRequest) GetPlan() []byte {
+func (m *Promotions) GetAppliedSourceWorkspaceId() string {
 	if m != nil {
-		return m.Plan
+		return m.AppliedSourceWorkspaceId
 	}
-	return nil
+	return ""
 }
 
-func (m *SaveLayoutRequest) GetDry() bool {
+func (m *Promotions) GetAppliedSourceVersion() string {
 	if m != nil {
-		return m.Dry
+		return m.AppliedSourceVersion
 	}
-	return false
-}
-
-type SaveLayoutResponse struct {
-	LayoutId             string   `protobuf:"bytes,1,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *SaveLayoutResponse) Reset()         { *m = SaveLayoutResponse{} }
-func (m *SaveLayoutResponse) String() string { return proto.CompactTextString(m) }
-func (*SaveLayoutResponse) ProtoMessage()    {}
-func (*SaveLayoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{13}
-}
-
-func (m *SaveLayoutResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SaveLayoutResponse.Unmarshal(m, b)
-}
-func (m *SaveLayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SaveLayoutResponse.Marshal(b, m, deterministic)
-}
-func (m *SaveLayoutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SaveLayoutResponse.Merge(m, src)
-}
-func (m *SaveLayoutResponse) XXX_Size() int {
-	return xxx_messageInfo_SaveLayoutResponse.Size(m)
-}
-func (m *SaveLayoutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SaveLayoutResponse.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_SaveLayoutResponse proto.InternalMessageInfo
-
-func (m *SaveLayoutResponse) GetLayoutId() string {
+func (m *Promotions) GetLatestSourceVersion() string {
 	if m != nil {
-		return m.LayoutId
+		return m.LatestSourceVersion
 	}
 	return ""
 }
 
-type SetLayoutStatusRequest struct {
-	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
-	Status               string   `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`
+type SaveWorkspaceRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Providers            []byte   `protobuf:"bytes,2,opt,name=Providers,proto3" json:"Providers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetLayoutStatusRequest) Reset()         { *m = SetLayoutStatusRequest{} }
-func (m *SetLayoutStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*SetLayoutStatusRequest) ProtoMessage()    {}
-func (*SetLayoutStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{14}
+func (m *SaveWorkspaceRequest) Reset()         { *m = SaveWorkspaceRequest{} }
+func (m *SaveWorkspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*SaveWorkspaceRequest) ProtoMessage()    {}
+func (*SaveWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{18}
 }
 
-func (m *SetLayoutStatusRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetLayoutStatusRequest.Unmarshal(m, b)
+func (m *SaveWorkspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveWorkspaceRequest.Unmarshal(m, b)
 }
-func (m *SetLayoutStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetLayoutStatusRequest.Marshal(b, m, deterministic)
+func (m *SaveWorkspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveWorkspaceRequest.Marshal(b, m, deterministic)
 }
-func (m *SetLayoutStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetLayoutStatusRequest.Merge(m, src)
+func (m *SaveWorkspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveWorkspaceRequest.Merge(m, src)
 }
-func (m *SetLayoutStatusRequest) XXX_Size() int {
-	return xxx_messageInfo_SetLayoutStatusRequest.Size(m)
+func (m *SaveWorkspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_SaveWorkspaceRequest.Size(m)
 }
-func (m *SetLayoutStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetLayoutStatusRequest.DiscardUnknown(m)
+func (m *SaveWorkspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveWorkspaceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetLayoutStatusRequest proto.InternalMessageInfo
-
-func (m *SetLayoutStatusRequest) GetWorkspaceId() string {
-	if m != nil {
-		return m.WorkspaceId
-	}
-	return ""
-}
+var xxx_messageInfo_SaveWorkspaceRequest proto.InternalMessageInfo
 
-func (m *SetLayoutStatusRequest) GetId() string {
+func (m *SaveWorkspaceRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *SetLayoutStatusRequest) GetStatus() string {
+func (m *SaveWorkspaceRequest) GetProviders() []byte {
 	if m != nil {
-		return m.Status
+		return m.Providers
 	}
-	return ""
+	return nil
 }
 
-type ApplyLayoutRequest struct {
-	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
-	Vars                 []byte   `protobuf:"bytes,3,opt,name=Vars,proto3" json:"Vars,omitempty"`
-	Dry                  bool     `protobuf:"varint,4,opt,name=dry,proto3" json:"dry,omitempty"`
-	Retry                int64    `protobuf:"varint,5,opt,name=Retry,proto3" json:"Retry,omitempty"`
+type GetWorkspaceLayoutsRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplyLayoutRequest) Reset()         { *m = ApplyLayoutRequest{} }
-func (m *ApplyLayoutRequest) String() string { return proto.CompactTextString(m) }
-func (*ApplyLayoutRequest) ProtoMessage()    {}
-func (*ApplyLayoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{15}
+func (m *GetWorkspaceLayoutsRequest) Reset()         { *m = GetWorkspaceLayoutsRequest{} }
+func (m *GetWorkspaceLayoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWorkspaceLayoutsRequest) ProtoMessage()    {}
+func (*GetWorkspaceLayoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{19}
 }
 
-func (m *ApplyLayoutRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ApplyLayoutRequest.Unmarshal(m, b)
+func (m *GetWorkspaceLayoutsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWorkspaceLayoutsRequest.Unmarshal(m, b)
 }
-func (m *ApplyLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ApplyLayoutRequest.Marshal(b, m, deterministic)
+func (m *GetWorkspaceLayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWorkspaceLayoutsRequest.Marshal(b, m, deterministic)
 }
-func (m *ApplyLayoutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplyLayoutRequest.Merge(m, src)
+func (m *GetWorkspaceLayoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkspaceLayoutsRequest.Merge(m, src)
 }
-func (m *ApplyLayoutRequest) XXX_Size() int {
-	return xxx_messageInfo_ApplyLayoutRequest.Size(m)
+func (m *GetWorkspaceLayoutsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetWorkspaceLayoutsRequest.Size(m)
 }
-func (m *ApplyLayoutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplyLayoutRequest.DiscardUnknown(m)
+func (m *GetWorkspaceLayoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkspaceLayoutsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplyLayoutRequest proto.InternalMessageInfo
-
-func (m *ApplyLayoutRequest) GetWorkspaceId() string {
-	if m != nil {
-		return m.WorkspaceId
-	}
-	return ""
-}
+var xxx_messageInfo_GetWorkspaceLayoutsRequest proto.InternalMessageInfo
 
-func (m *ApplyLayoutRequest) GetId() string {
+func (m *GetWorkspaceLayoutsRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *ApplyLayoutRequest) GetVars() []byte {
-	if m != nil {
-		return m.Vars
-	}
-	return nil
-}
-
-func (m *ApplyLayoutRequest) GetDry() bool {
-	if m != nil {
-		return m.Dry
-	}
-	return false
-}
-
-func (m *ApplyLayoutRequest) GetRetry() int64 {
-	if m != nil {
-		return m.Retry
-	}
-	return 0
-}
-
-type DestroyLayoutRequest struct {
-	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
-	Vars                 []byte   `protobuf:"bytes,3,opt,name=Vars,proto3" json:"Vars,omitempty"`
-	Retry                int64    `protobuf:"varint,4,opt,name=Retry,proto3" json:"Retry,omitempty"`
+type JobStatus struct {
+	Id     string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Status JobState `protobuf:"varint,2,opt,name=status,proto3,enum=tsocial.tessellate.server.JobState" json:"status,omitempty"`
+	// repeated bytes output = 3;
+	// repeated bytes error = 4;
+	Link string `protobuf:"bytes,5,opt,name=Link,proto3" json:"Link,omitempty"`
+	// Position of a QUEUED Job in the queue of its Layout, starting at 1.
+	QueuePosition        int32    `protobuf:"varint,6,opt,name=QueuePosition,proto3" json:"QueuePosition,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DestroyLayoutRequest) Reset()         { *m = DestroyLayoutRequest{} }
-func (m *DestroyLayoutRequest) String() string { return proto.CompactTextString(m) }
-func (*DestroyLayoutRequest) ProtoMessage()    {}
-func (*DestroyLayoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{16}
+func (m *JobStatus) Reset()         { *m = JobStatus{} }
+func (m *JobStatus) String() string { return proto.CompactTextString(m) }
+func (*JobStatus) ProtoMessage()    {}
+func (*JobStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{20}
 }
 
-func (m *DestroyLayoutRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DestroyLayoutRequest.Unmarshal(m, b)
+func (m *JobStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobStatus.Unmarshal(m, b)
 }
-func (m *DestroyLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DestroyLayoutRequest.Marshal(b, m, deterministic)
+func (m *JobStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobStatus.Marshal(b, m, deterministic)
 }
-func (m *DestroyLayoutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DestroyLayoutRequest.Merge(m, src)
+func (m *JobStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobStatus.Merge(m, src)
 }
-func (m *DestroyLayoutRequest) XXX_Size() int {
-	return xxx_messageInfo_DestroyLayoutRequest.Size(m)
+func (m *JobStatus) XXX_Size() int {
+	return xxx_messageInfo_JobStatus.Size(m)
 }
-func (m *DestroyLayoutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DestroyLayoutRequest.DiscardUnknown(m)
+func (m *JobStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DestroyLayoutRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobStatus proto.InternalMessageInfo
 
-func (m *DestroyLayoutRequest) GetWorkspaceId() string {
+func (m *JobStatus) GetId() string {
 	if m != nil {
-		return m.WorkspaceId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *DestroyLayoutRequest) GetId() string {
+func (m *JobStatus) GetStatus() JobState {
 	if m != nil {
-		return m.Id
+		return m.Status
 	}
-	return ""
+	return JobState_PENDING
 }
 
-func (m *DestroyLayoutRequest) GetVars() []byte {
+func (m *JobStatus) GetLink() string {
 	if m != nil {
-		return m.Vars
+		return m.Link
 	}
-	return nil
+	return ""
 }
 
-func (m *DestroyLayoutRequest) GetRetry() int64 {
+func (m *JobStatus) GetQueuePosition() int32 {
 	if m != nil {
-		return m.Retry
+		return m.QueuePosition
 	}
 	return 0
 }
 
-type StartWatchRequest struct {
-	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
-	SuccessCallback      string   `protobuf:"bytes,3,opt,name=SuccessCallback,proto3" json:"SuccessCallback,omitempty"`
-	FailureCallback      string   `protobuf:"bytes,4,opt,name=FailureCallback,proto3" json:"FailureCallback,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Job struct {
+	Id                   string    `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	WorkspaceId          string    `protobuf:"bytes,2,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	LayoutId             string    `protobuf:"bytes,3,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	LayoutVersion        string    `protobuf:"bytes,4,opt,name=LayoutVersion,proto3" json:"LayoutVersion,omitempty"`
+	VarsVersion          string    `protobuf:"bytes,5,opt,name=VarsVersion,proto3" json:"VarsVersion,omitempty"`
+	Op                   Operation `protobuf:"varint,6,opt,name=Op,proto3,enum=tsocial.tessellate.server.Operation" json:"Op,omitempty"`
+	Dry                  bool      `protobuf:"varint,7,opt,name=Dry,proto3" json:"Dry,omitempty"`
+	Retry                int64     `protobuf:"varint,8,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	Status               JobState  `protobuf:"varint,9,opt,name=Status,proto3,enum=tsocial.tessellate.server.JobState" json:"Status,omitempty"`
+	Error                string    `protobuf:"bytes,10,opt,name=Error,proto3" json:"Error,omitempty"`
+	StartedAt            int64     `protobuf:"varint,11,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
+	EndedAt              int64     `protobuf:"varint,12,opt,name=EndedAt,proto3" json:"EndedAt,omitempty"`
+	CreatedAt            int64     `protobuf:"varint,13,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	PlanJobId            string    `protobuf:"bytes,14,opt,name=PlanJobId,proto3" json:"PlanJobId,omitempty"`
+	StateSerial          int64     `protobuf:"varint,15,opt,name=StateSerial,proto3" json:"StateSerial,omitempty"`
+	QueuePosition        int32     `protobuf:"varint,16,opt,name=QueuePosition,proto3" json:"QueuePosition,omitempty"`
+	Drift                bool      `protobuf:"varint,17,opt,name=Drift,proto3" json:"Drift,omitempty"`
+	Targets              []string  `protobuf:"bytes,18,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Replace              []string  `protobuf:"bytes,19,rep,name=Replace,proto3" json:"Replace,omitempty"`
+	ImportAddress        string    `protobuf:"bytes,20,opt,name=ImportAddress,proto3" json:"ImportAddress,omitempty"`
+	ImportId             string    `protobuf:"bytes,21,opt,name=ImportId,proto3" json:"ImportId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *StartWatchRequest) Reset()         { *m = StartWatchRequest{} }
-func (m *StartWatchRequest) String() string { return proto.CompactTextString(m) }
-func (*StartWatchRequest) ProtoMessage()    {}
-func (*StartWatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{17}
+func (m *Job) Reset()         { *m = Job{} }
+func (m *Job) String() string { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()    {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{21}
 }
 
-func (m *StartWatchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StartWatchRequest.Unmarshal(m, b)
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Job.Unmarshal(m, b)
 }
-func (m *StartWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StartWatchRequest.Marshal(b, m, deterministic)
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Job.Marshal(b, m, deterministic)
 }
-func (m *StartWatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StartWatchRequest.Merge(m, src)
+func (m *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(m, src)
 }
-func (m *StartWatchRequest) XXX_Size() int {
-	return xxx_messageInfo_StartWatchRequest.Size(m)
+func (m *Job) XXX_Size() int {
+	return xxx_messageInfo_Job.Size(m)
 }
-func (m *StartWatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StartWatchRequest.DiscardUnknown(m)
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StartWatchRequest proto.InternalMessageInfo
+var xxx_messageInfo_Job proto.InternalMessageInfo
 
-func (m *StartWatchRequest) GetWorkspaceId() string {
+func (m *Job) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Job) GetWorkspaceId() string {
 	if m != nil {
 		return m.WorkspaceId
 	}
 	return ""
 }
 
-func (m *StartWatchRequest) GetId() string {
+func (m *Job) GetLayoutId() string {
 	if m != nil {
-		return m.Id
+		return m.LayoutId
 	}
 	return ""
 }
 
-func (m *StartWatchRequest) GetSuccessCallback() string {
+func (m *Job) GetLayoutVersion() string {
 	if m != nil {
-		return m.SuccessCallback
+		return m.LayoutVersion
 	}
 	return ""
 }
 
-func (m *StartWatchRequest) GetFailureCallback() string {
+func (m *Job) GetVarsVersion() string {
 	if m != nil {
-		return m.FailureCallback
+		return m.VarsVersion
 	}
 	return ""
 }
 
-type StopWatchRequest struct {
-	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Job) GetOp() Operation {
+	if m != nil {
+		return m.Op
+	}
+	return Operation_APPLY
 }
 
-func (m *StopWatchRequest) Reset()         { *m = StopWatchRequest{} }
-func (m *StopWatchRequest) String() string { return proto.CompactTextString(m) }
-func (*StopWatchRequest) ProtoMessage()    {}
-func (*StopWatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{18}
+func (m *Job) GetDry() bool {
+	if m != nil {
+		return m.Dry
+	}
+	return false
 }
 
-func (m *StopWatchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StopWatchRequest.Unmarshal(m, b)
+func (m *Job) GetRetry() int64 {
+	if m != nil {
+		return m.Retry
+	}
+	return 0
 }
-func (m *StopWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StopWatchRequest.Marshal(b, m, deterministic)
+
+func (m *Job) GetStatus() JobState {
+	if m != nil {
+		return m.Status
+	}
+	return JobState_PENDING
 }
-func (m *StopWatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopWatchRequest.Merge(m, src)
+
+func (m *Job) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
-func (m *StopWatchRequest) XXX_Size() int {
-	return xxx_messageInfo_StopWatchRequest.Size(m)
+
+func (m *Job) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
 }
-func (m *StopWatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopWatchRequest.DiscardUnknown(m)
+
+func (m *Job) GetEndedAt() int64 {
+	if m != nil {
+		return m.EndedAt
+	}
+	return 0
 }
 
-var xxx_messageInfo_StopWatchRequest proto.InternalMessageInfo
+func (m *Job) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
 
-func (m *StopWatchRequest) GetWorkspaceId() string {
+func (m *Job) GetPlanJobId() string {
 	if m != nil {
-		return m.WorkspaceId
+		return m.PlanJobId
 	}
 	return ""
 }
 
-func (m *StopWatchRequest) GetId() string {
+func (m *Job) GetStateSerial() int64 {
 	if m != nil {
-		return m.Id
+		return m.StateSerial
+	}
+	return 0
+}
+
+func (m *Job) GetQueuePosition() int32 {
+	if m != nil {
+		return m.QueuePosition
+	}
+	return 0
+}
+
+func (m *Job) GetDrift() bool {
+	if m != nil {
+		return m.Drift
+	}
+	return false
+}
+
+func (m *Job) GetTargets() []string {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *Job) GetReplace() []string {
+	if m != nil {
+		return m.Replace
+	}
+	return nil
+}
+
+func (m *Job) GetImportAddress() string {
+	if m != nil {
+		return m.ImportAddress
 	}
 	return ""
 }
 
-type GetStateRequest struct {
-	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
-	LayoutId             string   `protobuf:"bytes,2,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+func (m *Job) GetImportId() string {
+	if m != nil {
+		return m.ImportId
+	}
+	return ""
+}
+
+type ListJobsRequest struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	LayoutId    string `protobuf:"bytes,2,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	// Only list Jobs in these states, all of them if empty.
+	States []JobState `protobuf:"varint,3,rep,packed,name=States,proto3,enum=tsocial.tessellate.server.JobState" json:"States,omitempty"`
+	// NextPageToken of the previous page.
+	PageToken            string   `protobuf:"bytes,4,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	PageSize             int32    `protobuf:"varint,5,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetStateRequest) Reset()         { *m = GetStateRequest{} }
-func (m *GetStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetStateRequest) ProtoMessage()    {}
-func (*GetStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{19}
+func (m *ListJobsRequest) Reset()         { *m = ListJobsRequest{} }
+func (m *ListJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListJobsRequest) ProtoMessage()    {}
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{22}
 }
 
-func (m *GetStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetStateRequest.Unmarshal(m, b)
+func (m *ListJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJobsRequest.Unmarshal(m, b)
 }
-func (m *GetStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetStateRequest.Marshal(b, m, deterministic)
+func (m *ListJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJobsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStateRequest.Merge(m, src)
+func (m *ListJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsRequest.Merge(m, src)
 }
-func (m *GetStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetStateRequest.Size(m)
+func (m *ListJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListJobsRequest.Size(m)
 }
-func (m *GetStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetStateRequest.DiscardUnknown(m)
+func (m *ListJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListJobsRequest proto.InternalMessageInfo
 
-func (m *GetStateRequest) GetWorkspaceId() string {
+func (m *ListJobsRequest) GetWorkspaceId() string {
 	if m != nil {
 		return m.WorkspaceId
 	}
 	return ""
 }
 
-func (m *GetStateRequest) GetLayoutId() string {
+func (m *ListJobsRequest) GetLayoutId() string {
 	if m != nil {
 		return m.LayoutId
 	}
 	return ""
 }
 
-type GetStateResponse struct {
-	State                []byte   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+func (m *ListJobsRequest) GetStates() []JobState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *ListJobsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListJobsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type Jobs struct {
+	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetStateResponse) Reset()         { *m = GetStateResponse{} }
-func (m *GetStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetStateResponse) ProtoMessage()    {}
-func (*GetStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{20}
+func (m *Jobs) Reset()         { *m = Jobs{} }
+func (m *Jobs) String() string { return proto.CompactTextString(m) }
+func (*Jobs) ProtoMessage()    {}
+func (*Jobs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{23}
 }
 
-func (m *GetStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetStateResponse.Unmarshal(m, b)
+func (m *Jobs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Jobs.Unmarshal(m, b)
 }
-func (m *GetStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetStateResponse.Marshal(b, m, deterministic)
+func (m *Jobs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Jobs.Marshal(b, m, deterministic)
 }
-func (m *GetStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStateResponse.Merge(m, src)
+func (m *Jobs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Jobs.Merge(m, src)
 }
-func (m *GetStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetStateResponse.Size(m)
+func (m *Jobs) XXX_Size() int {
+	return xxx_messageInfo_Jobs.Size(m)
 }
-func (m *GetStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetStateResponse.DiscardUnknown(m)
+func (m *Jobs) XXX_DiscardUnknown() {
+	xxx_messageInfo_Jobs.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_Jobs proto.InternalMessageInfo
 
-func (m *GetStateResponse) GetState() []byte {
+func (m *Jobs) GetJobs() []*Job {
 	if m != nil {
-		return m.State
+		return m.Jobs
 	}
 	return nil
 }
 
-type GetOutputRequest struct {
-	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
-	LayoutId             string   `protobuf:"bytes,2,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+func (m *Jobs) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type JobLog struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetOutputRequest) Reset()         { *m = GetOutputRequest{} }
-func (m *GetOutputRequest) String() string { return proto.CompactTextString(m) }
-func (*GetOutputRequest) ProtoMessage()    {}
-func (*GetOutputRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{21}
+func (m *JobLog) Reset()         { *m = JobLog{} }
+func (m *JobLog) String() string { return proto.CompactTextString(m) }
+func (*JobLog) ProtoMessage()    {}
+func (*JobLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{24}
 }
 
-func (m *GetOutputRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetOutputRequest.Unmarshal(m, b)
+func (m *JobLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobLog.Unmarshal(m, b)
 }
-func (m *GetOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetOutputRequest.Marshal(b, m, deterministic)
+func (m *JobLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobLog.Marshal(b, m, deterministic)
 }
-func (m *GetOutputRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOutputRequest.Merge(m, src)
+func (m *JobLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobLog.Merge(m, src)
 }
-func (m *GetOutputRequest) XXX_Size() int {
-	return xxx_messageInfo_GetOutputRequest.Size(m)
+func (m *JobLog) XXX_Size() int {
+	return xxx_messageInfo_JobLog.Size(m)
 }
-func (m *GetOutputRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOutputRequest.DiscardUnknown(m)
+func (m *JobLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobLog.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOutputRequest proto.InternalMessageInfo
-
-func (m *GetOutputRequest) GetWorkspaceId() string {
-	if m != nil {
-		return m.WorkspaceId
-	}
-	return ""
-}
+var xxx_messageInfo_JobLog proto.InternalMessageInfo
 
-func (m *GetOutputRequest) GetLayoutId() string {
+func (m *JobLog) GetData() []byte {
 	if m != nil {
-		return m.LayoutId
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-type GetOutputResponse struct {
-	Output               []byte   `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+type ResourceChange struct {
+	Address              string   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Action               Action   `protobuf:"varint,4,opt,name=Action,proto3,enum=tsocial.tessellate.server.Action" json:"Action,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetOutputResponse) Reset()         { *m = GetOutputResponse{} }
-func (m *GetOutputResponse) String() string { return proto.CompactTextString(m) }
-func (*GetOutputResponse) ProtoMessage()    {}
-func (*GetOutputResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f23e2eaca5ccbb15, []int{22}
+func (m *ResourceChange) Reset()         { *m = ResourceChange{} }
+func (m *ResourceChange) String() string { return proto.CompactTextString(m) }
+func (*ResourceChange) ProtoMessage()    {}
+func (*ResourceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{25}
 }
 
-func (m *GetOutputResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetOutputResponse.Unmarshal(m, b)
+func (m *ResourceChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceChange.Unmarshal(m, b)
 }
-func (m *GetOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetOutputResponse.Marshal(b, m, deterministic)
+func (m *ResourceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceChange.Marshal(b, m, deterministic)
 }
-func (m *GetOutputResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOutputResponse.Merge(m, src)
+func (m *ResourceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceChange.Merge(m, src)
 }
-func (m *GetOutputResponse) XXX_Size() int {
-	return xxx_messageInfo_GetOutputResponse.Size(m)
+func (m *ResourceChange) XXX_Size() int {
+	return xxx_messageInfo_ResourceChange.Size(m)
 }
-func (m *GetOutputResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOutputResponse.DiscardUnknown(m)
+func (m *ResourceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOutputResponse proto.InternalMessageInfo
+var xxx_messageInfo_ResourceChange proto.InternalMessageInfo
 
-func (m *GetOutputResponse) GetOutput() []byte {
+func (m *ResourceChange) GetAddress() string {
 	if m != nil {
-		return m.Output
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("tsocial.tessellate.server.Errors", Errors_name, Errors_value)
-	proto.RegisterEnum("tsocial.tessellate.server.Status", Status_name, Status_value)
-	proto.RegisterEnum("tsocial.tessellate.server.JobState", JobState_name, JobState_value)
-	proto.RegisterEnum("tsocial.tessellate.server.Operation", Operation_name, Operation_value)
-	proto.RegisterType((*GetWorkspaceRequest)(nil), "tsocial.tessellate.server.GetWorkspaceRequest")
-	proto.RegisterType((*Workspace)(nil), "tsocial.tessellate.server.Workspace")
-	proto.RegisterType((*AllWorkspaces)(nil), "tsocial.tessellate.server.AllWorkspaces")
-	proto.RegisterType((*Layouts)(nil), "tsocial.tessellate.server.Layouts")
-	proto.RegisterType((*Layout)(nil), "tsocial.tessellate.server.Layout")
-	proto.RegisterType((*SaveWorkspaceRequest)(nil), "tsocial.tessellate.server.SaveWorkspaceRequest")
-	proto.RegisterType((*GetWorkspaceLayoutsRequest)(nil), "tsocial.tessellate.server.GetWorkspaceLayoutsRequest")
-	proto.RegisterType((*JobStatus)(nil), "tsocial.tessellate.server.JobStatus")
-	proto.RegisterType((*Vars)(nil), "tsocial.tessellate.server.Vars")
-	proto.RegisterType((*JobRequest)(nil), "tsocial.tessellate.server.JobRequest")
-	proto.RegisterType((*Ok)(nil), "tsocial.tessellate.server.Ok")
-	proto.RegisterType((*LayoutRequest)(nil), "tsocial.tessellate.server.LayoutRequest")
-	proto.RegisterType((*SaveLayoutRequest)(nil), "tsocial.tessellate.server.SaveLayoutRequest")
-	proto.RegisterType((*SaveLayoutResponse)(nil), "tsocial.tessellate.server.SaveLayoutResponse")
-	proto.RegisterType((*SetLayoutStatusRequest)(nil), "tsocial.tessellate.server.SetLayoutStatusRequest")
-	proto.RegisterType((*ApplyLayoutRequest)(nil), "tsocial.tessellate.server.ApplyLayoutRequest")
-	proto.RegisterType((*DestroyLayoutRequest)(nil), "tsocial.tessellate.server.DestroyLayoutRequest")
-	proto.RegisterType((*StartWatchRequest)(nil), "tsocial.tessellate.server.StartWatchRequest")
-	proto.RegisterType((*StopWatchRequest)(nil), "tsocial.tessellate.server.StopWatchRequest")
-	proto.RegisterType((*GetStateRequest)(nil), "tsocial.tessellate.server.GetStateRequest")
-	proto.RegisterType((*GetStateResponse)(nil), "tsocial.tessellate.server.GetStateResponse")
-	proto.RegisterType((*GetOutputRequest)(nil), "tsocial.tessellate.server.GetOutputRequest")
-	proto.RegisterType((*GetOutputResponse)(nil), "tsocial.tessellate.server.GetOutputResponse")
+func (m *ResourceChange) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("proto/tessellate.proto", fileDescriptor_f23e2eaca5ccbb15) }
+func (m *ResourceChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
 
-var fileDescriptor_f23e2eaca5ccbb15 = []byte{
-	// 1244 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0x9d, 0x6c, 0x7e, 0x4e, 0x36, 0xad, 0x77, 0x58, 0x2d, 0xc1, 0x6a, 0xa5, 0x30, 0x6d,
-	0x69, 0x9a, 0x76, 0x63, 0x5a, 0x54, 0xf1, 0xd3, 0x2b, 0x6f, 0x93, 0x5d, 0xb9, 0xa4, 0xf6, 0xca,
-	0xc9, 0xa6, 0x14, 0x84, 0x2a, 0x27, 0x19, 0xed, 0x46, 0xeb, 0xc6, 0xc1, 0x9e, 0x04, 0x22, 0x54,
-	0x09, 0x71, 0xd7, 0x0b, 0xae, 0xe0, 0x82, 0x0b, 0x10, 0x0f, 0xc0, 0x73, 0xf0, 0x04, 0xbc, 0x02,
-	0x4f, 0xc1, 0x15, 0xf2, 0xcc, 0xc4, 0x71, 0x76, 0x17, 0x27, 0xa0, 0x85, 0xbb, 0x99, 0xe3, 0xf3,
-	0xf3, 0xcd, 0x99, 0x73, 0xe6, 0x3b, 0x86, 0x9d, 0xb1, 0xef, 0x51, 0x4f, 0xa3, 0x24, 0x08, 0x88,
-	0xeb, 0x3a, 0x94, 0xd4, 0x99, 0x00, 0xbd, 0x45, 0x03, 0xaf, 0x3f, 0x74, 0xdc, 0x7a, 0xec, 0x4b,
-	0x40, 0xfc, 0x29, 0xf1, 0xd5, 0x6b, 0xc7, 0x9e, 0x77, 0xec, 0x12, 0xcd, 0x19, 0x0f, 0x35, 0x67,
-	0x34, 0xf2, 0xa8, 0x43, 0x87, 0xde, 0x28, 0xe0, 0x86, 0xaa, 0x7e, 0x3c, 0xa4, 0x27, 0x93, 0x5e,
-	0xbd, 0xef, 0xbd, 0xd4, 0xc8, 0x68, 0xea, 0xcd, 0xc6, 0xbe, 0xf7, 0xd5, 0x4c, 0x63, 0x1f, 0xfb,
-	0xbb, 0xc7, 0x64, 0xb4, 0x3b, 0x75, 0xdc, 0xe1, 0xc0, 0xa1, 0x44, 0x3b, 0xb7, 0xe0, 0x2e, 0x70,
-	0x1d, 0xde, 0x38, 0x20, 0xf4, 0x99, 0xe7, 0x9f, 0x06, 0x63, 0xa7, 0x4f, 0x6c, 0xf2, 0xc5, 0x84,
-	0x04, 0x14, 0xbd, 0x09, 0xb2, 0x31, 0x28, 0x4b, 0x15, 0xa9, 0x5a, 0xd8, 0xcb, 0xfd, 0xb9, 0x97,
-	0xf1, 0x65, 0x45, 0xb2, 0x65, 0x63, 0x80, 0x87, 0x50, 0x88, 0x94, 0x11, 0x82, 0x8c, 0xe9, 0xbc,
-	0x24, 0x5c, 0xcf, 0x66, 0xeb, 0x50, 0xd6, 0x75, 0xfc, 0xa0, 0x2c, 0x57, 0xa4, 0xea, 0xa6, 0xcd,
-	0xd6, 0xa8, 0x0c, 0xb9, 0x2e, 0xf1, 0x83, 0xa1, 0x37, 0x2a, 0xa7, 0x99, 0xea, 0x7c, 0x8b, 0x54,
-	0xc8, 0x8b, 0x65, 0x50, 0xce, 0x54, 0xd2, 0xd5, 0x82, 0x1d, 0xed, 0xf1, 0x11, 0x94, 0x74, 0xd7,
-	0x8d, 0xa2, 0x05, 0xa8, 0x01, 0xb0, 0xd8, 0x95, 0xa5, 0x4a, 0xba, 0x5a, 0x7c, 0x70, 0xb3, 0xfe,
-	0xb7, 0xc9, 0xab, 0x2f, 0x4e, 0x15, 0xb3, 0xc3, 0xfb, 0x90, 0x6b, 0x39, 0x33, 0x6f, 0x42, 0x03,
-	0xf4, 0x08, 0x72, 0x2e, 0x5f, 0x0a, 0x6f, 0x6f, 0x27, 0x78, 0xe3, 0x46, 0xf6, 0xdc, 0x02, 0xbf,
-	0x96, 0x20, 0xcb, 0x65, 0xa8, 0x02, 0xc5, 0x28, 0xc0, 0x50, 0xa4, 0xcd, 0x8e, 0x8b, 0xd0, 0x15,
-	0x96, 0x4f, 0x99, 0x7d, 0x90, 0x8d, 0x41, 0x98, 0xa5, 0x43, 0xd7, 0xe1, 0xe9, 0xd8, 0xb4, 0xd9,
-	0x1a, 0x7d, 0x08, 0xd9, 0x36, 0x75, 0xe8, 0x24, 0x28, 0x6f, 0x54, 0xa4, 0xea, 0x95, 0x44, 0x30,
-	0x5c, 0xd1, 0x16, 0x06, 0xf8, 0x29, 0x6c, 0xb7, 0x9d, 0x29, 0x59, 0xfb, 0x1a, 0xd1, 0x35, 0x28,
-	0x1c, 0xfa, 0xde, 0x74, 0x38, 0x20, 0xd1, 0x55, 0x2d, 0x04, 0xf8, 0x21, 0xa8, 0xf1, 0xa2, 0x10,
-	0xe9, 0x5a, 0x59, 0x1b, 0x9f, 0x40, 0xe1, 0x89, 0xd7, 0xe3, 0x90, 0xc4, 0x89, 0xa5, 0xe8, 0xc4,
-	0x8f, 0x20, 0x1b, 0xf0, 0xd3, 0xc9, 0xec, 0x74, 0x37, 0x12, 0x4e, 0x27, 0xbc, 0x10, 0x5b, 0x98,
-	0x60, 0x95, 0x17, 0x55, 0x54, 0x5c, 0xd2, 0xa2, 0xb8, 0xf0, 0x2d, 0x80, 0x27, 0x5e, 0x6f, 0x25,
-	0xb8, 0x0c, 0xc8, 0xd6, 0x29, 0x6e, 0x43, 0x49, 0xdc, 0xa3, 0xd0, 0xbf, 0x13, 0xbb, 0xba, 0xf3,
-	0x86, 0xf1, 0x6f, 0xc2, 0xb5, 0x7c, 0xde, 0xf5, 0x2b, 0xd8, 0x0a, 0xb3, 0x7f, 0xe9, 0x8e, 0x2f,
-	0xac, 0x12, 0x05, 0xd2, 0x0d, 0x7f, 0x56, 0xce, 0x54, 0xa4, 0x6a, 0xde, 0x0e, 0x97, 0xf8, 0x5d,
-	0x40, 0xf1, 0xf0, 0xc1, 0xd8, 0x1b, 0x05, 0x24, 0xec, 0x2c, 0x2e, 0x89, 0x6e, 0x21, 0xda, 0x63,
-	0x0a, 0x3b, 0x6d, 0x42, 0xf9, 0x56, 0x54, 0xd2, 0x25, 0xa2, 0xde, 0x89, 0xea, 0x98, 0x37, 0xfb,
-	0xbc, 0x48, 0x7f, 0x96, 0x00, 0xe9, 0xe3, 0xb1, 0x3b, 0xfb, 0x4f, 0x12, 0xc5, 0xea, 0x22, 0x1d,
-	0x7b, 0x74, 0x14, 0x48, 0x0f, 0x16, 0x89, 0x1a, 0xf8, 0x33, 0x74, 0x1d, 0x36, 0x6c, 0x42, 0xfd,
-	0x19, 0xeb, 0xaf, 0x34, 0xf3, 0x80, 0xe5, 0x6a, 0xca, 0xe6, 0x52, 0xfc, 0x9d, 0x04, 0xdb, 0x0d,
-	0x12, 0x50, 0xdf, 0xfb, 0x9f, 0x10, 0x46, 0x78, 0x32, 0x17, 0xe2, 0xf9, 0x55, 0x82, 0xad, 0x36,
-	0x75, 0x7c, 0xfa, 0xcc, 0xa1, 0xfd, 0x93, 0xcb, 0x04, 0x53, 0x85, 0xab, 0xed, 0x49, 0xbf, 0x4f,
-	0x82, 0xe0, 0xb1, 0xe3, 0xba, 0x3d, 0xa7, 0x7f, 0x2a, 0xae, 0xea, 0xac, 0x38, 0xd4, 0xdc, 0x77,
-	0x86, 0xee, 0xc4, 0x27, 0x91, 0x66, 0x86, 0x6b, 0x9e, 0x11, 0xe3, 0x2e, 0x28, 0x6d, 0xea, 0x8d,
-	0x2f, 0x1b, 0x2b, 0x76, 0xe0, 0xea, 0x01, 0xa1, 0xfc, 0x39, 0xf8, 0xe7, 0x6e, 0x6f, 0xc4, 0xba,
-	0xe0, 0x8c, 0xf3, 0x45, 0x3b, 0x54, 0x41, 0x59, 0x84, 0x10, 0xed, 0xb3, 0x0d, 0x1b, 0xe1, 0xdb,
-	0x43, 0xc4, 0x53, 0xc3, 0x37, 0xb8, 0xc7, 0x34, 0xad, 0x09, 0x1d, 0xff, 0xab, 0xea, 0x58, 0x0b,
-	0xcd, 0x5d, 0xd8, 0x8a, 0xc5, 0x10, 0x70, 0x76, 0x20, 0xeb, 0x31, 0x89, 0xc0, 0x23, 0x76, 0xb5,
-	0x11, 0x64, 0x9b, 0xbe, 0xef, 0xf9, 0x01, 0xba, 0x0a, 0x45, 0xd3, 0xea, 0xbc, 0xd0, 0x5b, 0x2d,
-	0xeb, 0x59, 0xb3, 0xa1, 0xa4, 0x50, 0x09, 0x0a, 0xa1, 0x60, 0xdf, 0x3a, 0x32, 0x1b, 0x8a, 0x84,
-	0x00, 0xb2, 0x2d, 0xeb, 0xf1, 0xc7, 0xcd, 0x86, 0x22, 0x23, 0x04, 0x57, 0x0c, 0xb3, 0xd3, 0xb4,
-	0x4d, 0xbd, 0xf5, 0xa2, 0x69, 0xdb, 0x96, 0xad, 0xa4, 0xd1, 0x16, 0x94, 0x0c, 0xb3, 0xab, 0xb7,
-	0x8c, 0xc6, 0x8b, 0xae, 0xde, 0x3a, 0x6a, 0x2a, 0x99, 0x50, 0xf4, 0xd4, 0x68, 0xb7, 0x0d, 0xf3,
-	0x40, 0x88, 0x36, 0x6a, 0x78, 0xde, 0xdb, 0x68, 0x13, 0xf2, 0x86, 0xa9, 0x3f, 0xee, 0x18, 0xdd,
-	0xa6, 0x92, 0x0a, 0xbd, 0x8b, 0xb5, 0x54, 0xb3, 0x21, 0x3f, 0x7f, 0xc0, 0x51, 0x11, 0x72, 0x87,
-	0x4d, 0xb3, 0x61, 0x98, 0x07, 0x4a, 0x2a, 0xdc, 0xd8, 0x47, 0xa6, 0x19, 0x6e, 0x18, 0x9e, 0x7d,
-	0xdd, 0x68, 0x31, 0x3c, 0x45, 0xc8, 0xe9, 0x7b, 0x96, 0xdd, 0x69, 0x36, 0x94, 0x34, 0xca, 0x43,
-	0xa6, 0x61, 0x99, 0x61, 0xfc, 0x02, 0x6c, 0x70, 0x74, 0x1b, 0xb5, 0x1b, 0x50, 0xb0, 0xc6, 0xc4,
-	0x67, 0xd3, 0x4f, 0x28, 0xd7, 0x0f, 0x0f, 0x5b, 0xcf, 0xb9, 0xcb, 0x46, 0xb3, 0xdd, 0xb1, 0xad,
-	0xe7, 0x8a, 0xf4, 0xe0, 0xb7, 0x4d, 0x80, 0x4e, 0x44, 0x26, 0x68, 0x06, 0xa5, 0x25, 0x52, 0x44,
-	0x5a, 0x12, 0xa1, 0x5e, 0x40, 0x9f, 0xea, 0xf5, 0x04, 0x03, 0xeb, 0x14, 0x97, 0xbf, 0xfd, 0xfd,
-	0x8f, 0xef, 0x65, 0x84, 0x4b, 0xda, 0xf4, 0xbe, 0xf6, 0xe5, 0xdc, 0xf8, 0x23, 0xa9, 0x86, 0xbe,
-	0x91, 0x60, 0x33, 0xce, 0xa0, 0xa8, 0x9e, 0xe0, 0xe9, 0x82, 0xf9, 0x4b, 0x5d, 0x6b, 0xac, 0xc1,
-	0x2a, 0x03, 0xb0, 0x8d, 0xd0, 0x12, 0x00, 0xed, 0x6b, 0x63, 0xf0, 0x0a, 0xfd, 0x20, 0x2d, 0x4f,
-	0x76, 0xf3, 0x99, 0xe7, 0xe1, 0x9a, 0x48, 0x96, 0x49, 0x5f, 0xc5, 0x2b, 0x27, 0xa3, 0x00, 0x63,
-	0x06, 0xe7, 0x1a, 0x52, 0xcf, 0xc3, 0xd1, 0xc4, 0xd4, 0x84, 0x7e, 0x94, 0x00, 0x16, 0x6c, 0x85,
-	0xee, 0xad, 0xb8, 0x92, 0xa5, 0x87, 0x58, 0xdd, 0x5d, 0x53, 0x9b, 0x37, 0x0d, 0xde, 0x65, 0x78,
-	0x6e, 0x63, 0x7c, 0x06, 0x4f, 0xac, 0x25, 0xe7, 0xc0, 0xc2, 0x4b, 0x7b, 0x2d, 0x41, 0xe1, 0x60,
-	0x4e, 0x8b, 0xa8, 0xba, 0x7a, 0x14, 0x14, 0xa8, 0x56, 0x0f, 0x8d, 0x58, 0x63, 0x48, 0xee, 0xa0,
-	0xdb, 0xab, 0x91, 0xf0, 0xdb, 0xfb, 0x49, 0x82, 0x62, 0x8c, 0x2b, 0x51, 0xd2, 0xc9, 0xcf, 0x73,
-	0x6a, 0x62, 0xf9, 0x44, 0x23, 0x1a, 0xfe, 0x80, 0xa1, 0x7a, 0x80, 0x77, 0xd7, 0x44, 0xa5, 0x39,
-	0x61, 0xa4, 0x30, 0x55, 0xbf, 0x48, 0x50, 0x5a, 0xa2, 0xca, 0xc4, 0xde, 0xba, 0x88, 0x54, 0xd7,
-	0x84, 0xf8, 0x3e, 0x83, 0x78, 0xbf, 0xa6, 0xad, 0x0b, 0x71, 0xc0, 0x63, 0x21, 0x1b, 0xf2, 0x7a,
-	0xcf, 0xf3, 0xe9, 0x13, 0xaf, 0x87, 0x6e, 0x25, 0x87, 0x5a, 0xb3, 0xdb, 0x53, 0xe8, 0x33, 0x80,
-	0x05, 0x1f, 0x27, 0x97, 0xee, 0x59, 0xda, 0x5e, 0xed, 0xfc, 0x39, 0x14, 0x22, 0xfe, 0x44, 0x77,
-	0x13, 0x7d, 0x2f, 0xb3, 0xec, 0x6a, 0xd7, 0x04, 0xf2, 0x73, 0x7e, 0x43, 0xb5, 0xe4, 0xf6, 0x8f,
-	0xf3, 0xac, 0x7a, 0x77, 0x2d, 0x5d, 0xd1, 0x6c, 0x29, 0x74, 0xc2, 0xda, 0x87, 0x13, 0x17, 0x5a,
-	0x61, 0xbb, 0x44, 0xa1, 0xea, 0xbd, 0xf5, 0x94, 0xa3, 0x48, 0x9f, 0x33, 0x1a, 0x5e, 0xfe, 0x39,
-	0x4c, 0xce, 0x82, 0x9a, 0xd4, 0xce, 0x4b, 0x8e, 0x70, 0x6a, 0xef, 0x9d, 0x4f, 0x6f, 0xc6, 0x7e,
-	0xac, 0x85, 0x5d, 0xec, 0xb7, 0x5d, 0xe3, 0x76, 0xbd, 0x2c, 0xfb, 0x85, 0x7e, 0xef, 0xaf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x30, 0x55, 0x71, 0x57, 0xd8, 0x0f, 0x00, 0x00,
+func (m *ResourceChange) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return Action_CREATE
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+type Plan struct {
+	JobId                string            `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	Changes              []*ResourceChange `protobuf:"bytes,2,rep,name=Changes,proto3" json:"Changes,omitempty"`
+	Add                  int32             `protobuf:"varint,3,opt,name=Add,proto3" json:"Add,omitempty"`
+	Change               int32             `protobuf:"varint,4,opt,name=Change,proto3" json:"Change,omitempty"`
+	Destroy              int32             `protobuf:"varint,5,opt,name=Destroy,proto3" json:"Destroy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *Plan) Reset()         { *m = Plan{} }
+func (m *Plan) String() string { return proto.CompactTextString(m) }
+func (*Plan) ProtoMessage()    {}
+func (*Plan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{26}
+}
 
-// TessellateClient is the client API for Tessellate service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type TessellateClient interface {
-	SaveWorkspace(ctx context.Context, in *SaveWorkspaceRequest, opts ...grpc.CallOption) (*Ok, error)
-	GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*Workspace, error)
-	GetWorkspaceLayouts(ctx context.Context, in *GetWorkspaceLayoutsRequest, opts ...grpc.CallOption) (*Layouts, error)
+func (m *Plan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Plan.Unmarshal(m, b)
+}
+func (m *Plan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Plan.Marshal(b, m, deterministic)
+}
+func (m *Plan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Plan.Merge(m, src)
+}
+func (m *Plan) XXX_Size() int {
+	return xxx_messageInfo_Plan.Size(m)
+}
+func (m *Plan) XXX_DiscardUnknown() {
+	xxx_messageInfo_Plan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Plan proto.InternalMessageInfo
+
+func (m *Plan) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *Plan) GetChanges() []*ResourceChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *Plan) GetAdd() int32 {
+	if m != nil {
+		return m.Add
+	}
+	return 0
+}
+
+func (m *Plan) GetChange() int32 {
+	if m != nil {
+		return m.Change
+	}
+	return 0
+}
+
+func (m *Plan) GetDestroy() int32 {
+	if m != nil {
+		return m.Destroy
+	}
+	return 0
+}
+
+type Vars struct {
+	Vars                 []byte   `protobuf:"bytes,1,opt,name=Vars,proto3" json:"Vars,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Vars) Reset()         { *m = Vars{} }
+func (m *Vars) String() string { return proto.CompactTextString(m) }
+func (*Vars) ProtoMessage()    {}
+func (*Vars) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{27}
+}
+
+func (m *Vars) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Vars.Unmarshal(m, b)
+}
+func (m *Vars) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Vars.Marshal(b, m, deterministic)
+}
+func (m *Vars) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vars.Merge(m, src)
+}
+func (m *Vars) XXX_Size() int {
+	return xxx_messageInfo_Vars.Size(m)
+}
+func (m *Vars) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vars.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vars proto.InternalMessageInfo
+
+func (m *Vars) GetVars() []byte {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
+type JobRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	WorkspaceId          string   `protobuf:"bytes,2,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	LayoutId             string   `protobuf:"bytes,3,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobRequest) Reset()         { *m = JobRequest{} }
+func (m *JobRequest) String() string { return proto.CompactTextString(m) }
+func (*JobRequest) ProtoMessage()    {}
+func (*JobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{28}
+}
+
+func (m *JobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobRequest.Unmarshal(m, b)
+}
+func (m *JobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobRequest.Marshal(b, m, deterministic)
+}
+func (m *JobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRequest.Merge(m, src)
+}
+func (m *JobRequest) XXX_Size() int {
+	return xxx_messageInfo_JobRequest.Size(m)
+}
+func (m *JobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRequest proto.InternalMessageInfo
+
+func (m *JobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *JobRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *JobRequest) GetLayoutId() string {
+	if m != nil {
+		return m.LayoutId
+	}
+	return ""
+}
+
+type Ok struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Ok) Reset()         { *m = Ok{} }
+func (m *Ok) String() string { return proto.CompactTextString(m) }
+func (*Ok) ProtoMessage()    {}
+func (*Ok) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{29}
+}
+
+func (m *Ok) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Ok.Unmarshal(m, b)
+}
+func (m *Ok) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Ok.Marshal(b, m, deterministic)
+}
+func (m *Ok) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ok.Merge(m, src)
+}
+func (m *Ok) XXX_Size() int {
+	return xxx_messageInfo_Ok.Size(m)
+}
+func (m *Ok) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ok.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ok proto.InternalMessageInfo
+
+type LayoutRequest struct {
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LayoutRequest) Reset()         { *m = LayoutRequest{} }
+func (m *LayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*LayoutRequest) ProtoMessage()    {}
+func (*LayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{30}
+}
+
+func (m *LayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LayoutRequest.Unmarshal(m, b)
+}
+func (m *LayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *LayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LayoutRequest.Merge(m, src)
+}
+func (m *LayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_LayoutRequest.Size(m)
+}
+func (m *LayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LayoutRequest proto.InternalMessageInfo
+
+func (m *LayoutRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *LayoutRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type SaveLayoutRequest struct {
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Plan                 []byte   `protobuf:"bytes,3,opt,name=Plan,proto3" json:"Plan,omitempty"`
+	Dry                  bool     `protobuf:"varint,4,opt,name=Dry,proto3" json:"Dry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SaveLayoutRequest) Reset()         { *m = SaveLayoutRequest{} }
+func (m *SaveLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*SaveLayoutRequest) ProtoMessage()    {}
+func (*SaveLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{31}
+}
+
+func (m *SaveLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveLayoutRequest.Unmarshal(m, b)
+}
+func (m *SaveLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveLayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *SaveLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveLayoutRequest.Merge(m, src)
+}
+func (m *SaveLayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_SaveLayoutRequest.Size(m)
+}
+func (m *SaveLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveLayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveLayoutRequest proto.InternalMessageInfo
+
+func (m *SaveLayoutRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *SaveLayoutRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SaveLayoutRequest) GetPlan() []byte {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *SaveLayoutRequest) GetDry() bool {
+	if m != nil {
+		return m.Dry
+	}
+	return false
+}
+
+type SaveLayoutResponse struct {
+	LayoutId             string   `protobuf:"bytes,1,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SaveLayoutResponse) Reset()         { *m = SaveLayoutResponse{} }
+func (m *SaveLayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*SaveLayoutResponse) ProtoMessage()    {}
+func (*SaveLayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{32}
+}
+
+func (m *SaveLayoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveLayoutResponse.Unmarshal(m, b)
+}
+func (m *SaveLayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveLayoutResponse.Marshal(b, m, deterministic)
+}
+func (m *SaveLayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveLayoutResponse.Merge(m, src)
+}
+func (m *SaveLayoutResponse) XXX_Size() int {
+	return xxx_messageInfo_SaveLayoutResponse.Size(m)
+}
+func (m *SaveLayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveLayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveLayoutResponse proto.InternalMessageInfo
+
+func (m *SaveLayoutResponse) GetLayoutId() string {
+	if m != nil {
+		return m.LayoutId
+	}
+	return ""
+}
+
+type SetLayoutStatusRequest struct {
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLayoutStatusRequest) Reset()         { *m = SetLayoutStatusRequest{} }
+func (m *SetLayoutStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLayoutStatusRequest) ProtoMessage()    {}
+func (*SetLayoutStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{33}
+}
+
+func (m *SetLayoutStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLayoutStatusRequest.Unmarshal(m, b)
+}
+func (m *SetLayoutStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLayoutStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLayoutStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLayoutStatusRequest.Merge(m, src)
+}
+func (m *SetLayoutStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLayoutStatusRequest.Size(m)
+}
+func (m *SetLayoutStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLayoutStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLayoutStatusRequest proto.InternalMessageInfo
+
+func (m *SetLayoutStatusRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *SetLayoutStatusRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SetLayoutStatusRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type ApplyLayoutRequest struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Vars        []byte `protobuf:"bytes,3,opt,name=Vars,proto3" json:"Vars,omitempty"`
+	Dry         bool   `protobuf:"varint,4,opt,name=dry,proto3" json:"dry,omitempty"`
+	Retry       int64  `protobuf:"varint,5,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	// Apply the plan made by this dry Job, instead of making a new one.
+	PlanJobId string `protobuf:"bytes,6,opt,name=PlanJobId,proto3" json:"PlanJobId,omitempty"`
+	// Limit the run to these resources or modules, and what they depend on.
+	Targets []string `protobuf:"bytes,7,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	// Force these resources to be destroyed and created again.
+	Replace []string `protobuf:"bytes,8,rep,name=Replace,proto3" json:"Replace,omitempty"`
+	// Apply this version of the Layout instead of the latest.
+	LayoutVersion        string   `protobuf:"bytes,9,opt,name=LayoutVersion,proto3" json:"LayoutVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyLayoutRequest) Reset()         { *m = ApplyLayoutRequest{} }
+func (m *ApplyLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyLayoutRequest) ProtoMessage()    {}
+func (*ApplyLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{34}
+}
+
+func (m *ApplyLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyLayoutRequest.Unmarshal(m, b)
+}
+func (m *ApplyLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyLayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyLayoutRequest.Merge(m, src)
+}
+func (m *ApplyLayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyLayoutRequest.Size(m)
+}
+func (m *ApplyLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyLayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyLayoutRequest proto.InternalMessageInfo
+
+func (m *ApplyLayoutRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *ApplyLayoutRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApplyLayoutRequest) GetVars() []byte {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
+func (m *ApplyLayoutRequest) GetDry() bool {
+	if m != nil {
+		return m.Dry
+	}
+	return false
+}
+
+func (m *ApplyLayoutRequest) GetRetry() int64 {
+	if m != nil {
+		return m.Retry
+	}
+	return 0
+}
+
+func (m *ApplyLayoutRequest) GetPlanJobId() string {
+	if m != nil {
+		return m.PlanJobId
+	}
+	return ""
+}
+
+func (m *ApplyLayoutRequest) GetTargets() []string {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *ApplyLayoutRequest) GetReplace() []string {
+	if m != nil {
+		return m.Replace
+	}
+	return nil
+}
+
+func (m *ApplyLayoutRequest) GetLayoutVersion() string {
+	if m != nil {
+		return m.LayoutVersion
+	}
+	return ""
+}
+
+type RollbackLayoutRequest struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Roll back to the last successful apply before this Job.
+	// Defaults to the last successful apply, rolling back to the one before it.
+	JobId                string   `protobuf:"bytes,3,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	Retry                int64    `protobuf:"varint,4,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackLayoutRequest) Reset()         { *m = RollbackLayoutRequest{} }
+func (m *RollbackLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackLayoutRequest) ProtoMessage()    {}
+func (*RollbackLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{35}
+}
+
+func (m *RollbackLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackLayoutRequest.Unmarshal(m, b)
+}
+func (m *RollbackLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackLayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *RollbackLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackLayoutRequest.Merge(m, src)
+}
+func (m *RollbackLayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackLayoutRequest.Size(m)
+}
+func (m *RollbackLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackLayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackLayoutRequest proto.InternalMessageInfo
+
+func (m *RollbackLayoutRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *RollbackLayoutRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RollbackLayoutRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *RollbackLayoutRequest) GetRetry() int64 {
+	if m != nil {
+		return m.Retry
+	}
+	return 0
+}
+
+type DestroyLayoutRequest struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Vars        []byte `protobuf:"bytes,3,opt,name=Vars,proto3" json:"Vars,omitempty"`
+	Retry       int64  `protobuf:"varint,4,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	// Limit the run to these resources or modules, and what depends on them.
+	Targets              []string `protobuf:"bytes,5,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestroyLayoutRequest) Reset()         { *m = DestroyLayoutRequest{} }
+func (m *DestroyLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*DestroyLayoutRequest) ProtoMessage()    {}
+func (*DestroyLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{36}
+}
+
+func (m *DestroyLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestroyLayoutRequest.Unmarshal(m, b)
+}
+func (m *DestroyLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestroyLayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *DestroyLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestroyLayoutRequest.Merge(m, src)
+}
+func (m *DestroyLayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_DestroyLayoutRequest.Size(m)
+}
+func (m *DestroyLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestroyLayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestroyLayoutRequest proto.InternalMessageInfo
+
+func (m *DestroyLayoutRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *DestroyLayoutRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DestroyLayoutRequest) GetVars() []byte {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
+func (m *DestroyLayoutRequest) GetRetry() int64 {
+	if m != nil {
+		return m.Retry
+	}
+	return 0
+}
+
+func (m *DestroyLayoutRequest) GetTargets() []string {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+type DeleteLayoutRequest struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Delete even if the state still has resources, leaving them behind.
+	Force                bool     `protobuf:"varint,3,opt,name=Force,proto3" json:"Force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteLayoutRequest) Reset()         { *m = DeleteLayoutRequest{} }
+func (m *DeleteLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteLayoutRequest) ProtoMessage()    {}
+func (*DeleteLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{37}
+}
+
+func (m *DeleteLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteLayoutRequest.Unmarshal(m, b)
+}
+func (m *DeleteLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteLayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteLayoutRequest.Merge(m, src)
+}
+func (m *DeleteLayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteLayoutRequest.Size(m)
+}
+func (m *DeleteLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteLayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteLayoutRequest proto.InternalMessageInfo
+
+func (m *DeleteLayoutRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *DeleteLayoutRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteLayoutRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type DeleteWorkspaceRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteWorkspaceRequest) Reset()         { *m = DeleteWorkspaceRequest{} }
+func (m *DeleteWorkspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteWorkspaceRequest) ProtoMessage()    {}
+func (*DeleteWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{38}
+}
+
+func (m *DeleteWorkspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteWorkspaceRequest.Unmarshal(m, b)
+}
+func (m *DeleteWorkspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteWorkspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteWorkspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkspaceRequest.Merge(m, src)
+}
+func (m *DeleteWorkspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteWorkspaceRequest.Size(m)
+}
+func (m *DeleteWorkspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWorkspaceRequest proto.InternalMessageInfo
+
+func (m *DeleteWorkspaceRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type StackLayout struct {
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Layouts of the Stack that are applied before this one, and destroyed after it.
+	DependsOn            []string `protobuf:"bytes,2,rep,name=DependsOn,proto3" json:"DependsOn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StackLayout) Reset()         { *m = StackLayout{} }
+func (m *StackLayout) String() string { return proto.CompactTextString(m) }
+func (*StackLayout) ProtoMessage()    {}
+func (*StackLayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{39}
+}
+
+func (m *StackLayout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StackLayout.Unmarshal(m, b)
+}
+func (m *StackLayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StackLayout.Marshal(b, m, deterministic)
+}
+func (m *StackLayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StackLayout.Merge(m, src)
+}
+func (m *StackLayout) XXX_Size() int {
+	return xxx_messageInfo_StackLayout.Size(m)
+}
+func (m *StackLayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_StackLayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StackLayout proto.InternalMessageInfo
+
+func (m *StackLayout) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StackLayout) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+type SaveStackRequest struct {
+	WorkspaceId          string         `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id                   string         `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Layouts              []*StackLayout `protobuf:"bytes,3,rep,name=Layouts,proto3" json:"Layouts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SaveStackRequest) Reset()         { *m = SaveStackRequest{} }
+func (m *SaveStackRequest) String() string { return proto.CompactTextString(m) }
+func (*SaveStackRequest) ProtoMessage()    {}
+func (*SaveStackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{40}
+}
+
+func (m *SaveStackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveStackRequest.Unmarshal(m, b)
+}
+func (m *SaveStackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveStackRequest.Marshal(b, m, deterministic)
+}
+func (m *SaveStackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveStackRequest.Merge(m, src)
+}
+func (m *SaveStackRequest) XXX_Size() int {
+	return xxx_messageInfo_SaveStackRequest.Size(m)
+}
+func (m *SaveStackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveStackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveStackRequest proto.InternalMessageInfo
+
+func (m *SaveStackRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *SaveStackRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SaveStackRequest) GetLayouts() []*StackLayout {
+	if m != nil {
+		return m.Layouts
+	}
+	return nil
+}
+
+type StackRequest struct {
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Retry                int64    `protobuf:"varint,3,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StackRequest) Reset()         { *m = StackRequest{} }
+func (m *StackRequest) String() string { return proto.CompactTextString(m) }
+func (*StackRequest) ProtoMessage()    {}
+func (*StackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{41}
+}
+
+func (m *StackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StackRequest.Unmarshal(m, b)
+}
+func (m *StackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StackRequest.Marshal(b, m, deterministic)
+}
+func (m *StackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StackRequest.Merge(m, src)
+}
+func (m *StackRequest) XXX_Size() int {
+	return xxx_messageInfo_StackRequest.Size(m)
+}
+func (m *StackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StackRequest proto.InternalMessageInfo
+
+func (m *StackRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *StackRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StackRequest) GetRetry() int64 {
+	if m != nil {
+		return m.Retry
+	}
+	return 0
+}
+
+type StackLayer struct {
+	LayoutIds            []string `protobuf:"bytes,1,rep,name=LayoutIds,proto3" json:"LayoutIds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StackLayer) Reset()         { *m = StackLayer{} }
+func (m *StackLayer) String() string { return proto.CompactTextString(m) }
+func (*StackLayer) ProtoMessage()    {}
+func (*StackLayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{42}
+}
+
+func (m *StackLayer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StackLayer.Unmarshal(m, b)
+}
+func (m *StackLayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StackLayer.Marshal(b, m, deterministic)
+}
+func (m *StackLayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StackLayer.Merge(m, src)
+}
+func (m *StackLayer) XXX_Size() int {
+	return xxx_messageInfo_StackLayer.Size(m)
+}
+func (m *StackLayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_StackLayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StackLayer proto.InternalMessageInfo
+
+func (m *StackLayer) GetLayoutIds() []string {
+	if m != nil {
+		return m.LayoutIds
+	}
+	return nil
+}
+
+type Stack struct {
+	WorkspaceId string         `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id          string         `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Layouts     []*StackLayout `protobuf:"bytes,3,rep,name=Layouts,proto3" json:"Layouts,omitempty"`
+	// Layouts in the order they are applied, a layer at a time.
+	Layers []*StackLayer `protobuf:"bytes,4,rep,name=Layers,proto3" json:"Layers,omitempty"`
+	// Latest run of the Stack, if any.
+	LastRunId            string   `protobuf:"bytes,5,opt,name=LastRunId,proto3" json:"LastRunId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Stack) Reset()         { *m = Stack{} }
+func (m *Stack) String() string { return proto.CompactTextString(m) }
+func (*Stack) ProtoMessage()    {}
+func (*Stack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{43}
+}
+
+func (m *Stack) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Stack.Unmarshal(m, b)
+}
+func (m *Stack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Stack.Marshal(b, m, deterministic)
+}
+func (m *Stack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stack.Merge(m, src)
+}
+func (m *Stack) XXX_Size() int {
+	return xxx_messageInfo_Stack.Size(m)
+}
+func (m *Stack) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stack proto.InternalMessageInfo
+
+func (m *Stack) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *Stack) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Stack) GetLayouts() []*StackLayout {
+	if m != nil {
+		return m.Layouts
+	}
+	return nil
+}
+
+func (m *Stack) GetLayers() []*StackLayer {
+	if m != nil {
+		return m.Layers
+	}
+	return nil
+}
+
+func (m *Stack) GetLastRunId() string {
+	if m != nil {
+		return m.LastRunId
+	}
+	return ""
+}
+
+type StackRunRequest struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	StackId     string `protobuf:"bytes,2,opt,name=StackId,proto3" json:"StackId,omitempty"`
+	// Defaults to the latest run.
+	Id                   string   `protobuf:"bytes,3,opt,name=Id,proto3" json:"Id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StackRunRequest) Reset()         { *m = StackRunRequest{} }
+func (m *StackRunRequest) String() string { return proto.CompactTextString(m) }
+func (*StackRunRequest) ProtoMessage()    {}
+func (*StackRunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{44}
+}
+
+func (m *StackRunRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StackRunRequest.Unmarshal(m, b)
+}
+func (m *StackRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StackRunRequest.Marshal(b, m, deterministic)
+}
+func (m *StackRunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StackRunRequest.Merge(m, src)
+}
+func (m *StackRunRequest) XXX_Size() int {
+	return xxx_messageInfo_StackRunRequest.Size(m)
+}
+func (m *StackRunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StackRunRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StackRunRequest proto.InternalMessageInfo
+
+func (m *StackRunRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *StackRunRequest) GetStackId() string {
+	if m != nil {
+		return m.StackId
+	}
+	return ""
+}
+
+func (m *StackRunRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type StackJob struct {
+	LayoutId             string   `protobuf:"bytes,1,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	JobId                string   `protobuf:"bytes,2,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	Status               JobState `protobuf:"varint,3,opt,name=Status,proto3,enum=tsocial.tessellate.server.JobState" json:"Status,omitempty"`
+	Layer                int32    `protobuf:"varint,4,opt,name=Layer,proto3" json:"Layer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StackJob) Reset()         { *m = StackJob{} }
+func (m *StackJob) String() string { return proto.CompactTextString(m) }
+func (*StackJob) ProtoMessage()    {}
+func (*StackJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{45}
+}
+
+func (m *StackJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StackJob.Unmarshal(m, b)
+}
+func (m *StackJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StackJob.Marshal(b, m, deterministic)
+}
+func (m *StackJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StackJob.Merge(m, src)
+}
+func (m *StackJob) XXX_Size() int {
+	return xxx_messageInfo_StackJob.Size(m)
+}
+func (m *StackJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_StackJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StackJob proto.InternalMessageInfo
+
+func (m *StackJob) GetLayoutId() string {
+	if m != nil {
+		return m.LayoutId
+	}
+	return ""
+}
+
+func (m *StackJob) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *StackJob) GetStatus() JobState {
+	if m != nil {
+		return m.Status
+	}
+	return JobState_PENDING
+}
+
+func (m *StackJob) GetLayer() int32 {
+	if m != nil {
+		return m.Layer
+	}
+	return 0
+}
+
+type StackRun struct {
+	Id      string    `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	StackId string    `protobuf:"bytes,2,opt,name=StackId,proto3" json:"StackId,omitempty"`
+	Op      Operation `protobuf:"varint,3,opt,name=Op,proto3,enum=tsocial.tessellate.server.Operation" json:"Op,omitempty"`
+	// RUNNING till every layer is DONE, or a Job of a layer does not finish DONE.
+	Status JobState `protobuf:"varint,4,opt,name=Status,proto3,enum=tsocial.tessellate.server.JobState" json:"Status,omitempty"`
+	// Layer being run, counting from 0.
+	Layer  int32         `protobuf:"varint,5,opt,name=Layer,proto3" json:"Layer,omitempty"`
+	Layers []*StackLayer `protobuf:"bytes,6,rep,name=Layers,proto3" json:"Layers,omitempty"`
+	// Jobs of the layers run so far.
+	Jobs                 []*StackJob `protobuf:"bytes,7,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	Error                string      `protobuf:"bytes,8,opt,name=Error,proto3" json:"Error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StackRun) Reset()         { *m = StackRun{} }
+func (m *StackRun) String() string { return proto.CompactTextString(m) }
+func (*StackRun) ProtoMessage()    {}
+func (*StackRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{46}
+}
+
+func (m *StackRun) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StackRun.Unmarshal(m, b)
+}
+func (m *StackRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StackRun.Marshal(b, m, deterministic)
+}
+func (m *StackRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StackRun.Merge(m, src)
+}
+func (m *StackRun) XXX_Size() int {
+	return xxx_messageInfo_StackRun.Size(m)
+}
+func (m *StackRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_StackRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StackRun proto.InternalMessageInfo
+
+func (m *StackRun) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StackRun) GetStackId() string {
+	if m != nil {
+		return m.StackId
+	}
+	return ""
+}
+
+func (m *StackRun) GetOp() Operation {
+	if m != nil {
+		return m.Op
+	}
+	return Operation_APPLY
+}
+
+func (m *StackRun) GetStatus() JobState {
+	if m != nil {
+		return m.Status
+	}
+	return JobState_PENDING
+}
+
+func (m *StackRun) GetLayer() int32 {
+	if m != nil {
+		return m.Layer
+	}
+	return 0
+}
+
+func (m *StackRun) GetLayers() []*StackLayer {
+	if m != nil {
+		return m.Layers
+	}
+	return nil
+}
+
+func (m *StackRun) GetJobs() []*StackJob {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+func (m *StackRun) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type RefreshLayoutRequest struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Vars        []byte `protobuf:"bytes,3,opt,name=Vars,proto3" json:"Vars,omitempty"`
+	Retry       int64  `protobuf:"varint,4,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	// Limit the refresh to these resources or modules.
+	Targets              []string `protobuf:"bytes,5,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshLayoutRequest) Reset()         { *m = RefreshLayoutRequest{} }
+func (m *RefreshLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshLayoutRequest) ProtoMessage()    {}
+func (*RefreshLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{47}
+}
+
+func (m *RefreshLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshLayoutRequest.Unmarshal(m, b)
+}
+func (m *RefreshLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshLayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshLayoutRequest.Merge(m, src)
+}
+func (m *RefreshLayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshLayoutRequest.Size(m)
+}
+func (m *RefreshLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshLayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshLayoutRequest proto.InternalMessageInfo
+
+func (m *RefreshLayoutRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *RefreshLayoutRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RefreshLayoutRequest) GetVars() []byte {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
+func (m *RefreshLayoutRequest) GetRetry() int64 {
+	if m != nil {
+		return m.Retry
+	}
+	return 0
+}
+
+func (m *RefreshLayoutRequest) GetTargets() []string {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+type ImportResourceRequest struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Vars        []byte `protobuf:"bytes,3,opt,name=Vars,proto3" json:"Vars,omitempty"`
+	Retry       int64  `protobuf:"varint,4,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	// Address in the Layout to import the resource to.
+	Address string `protobuf:"bytes,5,opt,name=Address,proto3" json:"Address,omitempty"`
+	// ID of the existing resource, as known to its provider.
+	ResourceId           string   `protobuf:"bytes,6,opt,name=ResourceId,proto3" json:"ResourceId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportResourceRequest) Reset()         { *m = ImportResourceRequest{} }
+func (m *ImportResourceRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportResourceRequest) ProtoMessage()    {}
+func (*ImportResourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{48}
+}
+
+func (m *ImportResourceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResourceRequest.Unmarshal(m, b)
+}
+func (m *ImportResourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResourceRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportResourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResourceRequest.Merge(m, src)
+}
+func (m *ImportResourceRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportResourceRequest.Size(m)
+}
+func (m *ImportResourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResourceRequest proto.InternalMessageInfo
+
+func (m *ImportResourceRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *ImportResourceRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ImportResourceRequest) GetVars() []byte {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
+func (m *ImportResourceRequest) GetRetry() int64 {
+	if m != nil {
+		return m.Retry
+	}
+	return 0
+}
+
+func (m *ImportResourceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ImportResourceRequest) GetResourceId() string {
+	if m != nil {
+		return m.ResourceId
+	}
+	return ""
+}
+
+type StartWatchRequest struct {
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	SuccessCallback      string   `protobuf:"bytes,3,opt,name=SuccessCallback,proto3" json:"SuccessCallback,omitempty"`
+	FailureCallback      string   `protobuf:"bytes,4,opt,name=FailureCallback,proto3" json:"FailureCallback,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartWatchRequest) Reset()         { *m = StartWatchRequest{} }
+func (m *StartWatchRequest) String() string { return proto.CompactTextString(m) }
+func (*StartWatchRequest) ProtoMessage()    {}
+func (*StartWatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{49}
+}
+
+func (m *StartWatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartWatchRequest.Unmarshal(m, b)
+}
+func (m *StartWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartWatchRequest.Marshal(b, m, deterministic)
+}
+func (m *StartWatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartWatchRequest.Merge(m, src)
+}
+func (m *StartWatchRequest) XXX_Size() int {
+	return xxx_messageInfo_StartWatchRequest.Size(m)
+}
+func (m *StartWatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartWatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartWatchRequest proto.InternalMessageInfo
+
+func (m *StartWatchRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *StartWatchRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StartWatchRequest) GetSuccessCallback() string {
+	if m != nil {
+		return m.SuccessCallback
+	}
+	return ""
+}
+
+func (m *StartWatchRequest) GetFailureCallback() string {
+	if m != nil {
+		return m.FailureCallback
+	}
+	return ""
+}
+
+// Cron expression to check the Layout for drift. An empty Cron stops the checks.
+type DriftScheduleRequest struct {
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Cron                 string   `protobuf:"bytes,3,opt,name=Cron,proto3" json:"Cron,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DriftScheduleRequest) Reset()         { *m = DriftScheduleRequest{} }
+func (m *DriftScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*DriftScheduleRequest) ProtoMessage()    {}
+func (*DriftScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{50}
+}
+
+func (m *DriftScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DriftScheduleRequest.Unmarshal(m, b)
+}
+func (m *DriftScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DriftScheduleRequest.Marshal(b, m, deterministic)
+}
+func (m *DriftScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DriftScheduleRequest.Merge(m, src)
+}
+func (m *DriftScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_DriftScheduleRequest.Size(m)
+}
+func (m *DriftScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DriftScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DriftScheduleRequest proto.InternalMessageInfo
+
+func (m *DriftScheduleRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *DriftScheduleRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DriftScheduleRequest) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+type StopWatchRequest struct {
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopWatchRequest) Reset()         { *m = StopWatchRequest{} }
+func (m *StopWatchRequest) String() string { return proto.CompactTextString(m) }
+func (*StopWatchRequest) ProtoMessage()    {}
+func (*StopWatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{51}
+}
+
+func (m *StopWatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopWatchRequest.Unmarshal(m, b)
+}
+func (m *StopWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopWatchRequest.Marshal(b, m, deterministic)
+}
+func (m *StopWatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopWatchRequest.Merge(m, src)
+}
+func (m *StopWatchRequest) XXX_Size() int {
+	return xxx_messageInfo_StopWatchRequest.Size(m)
+}
+func (m *StopWatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopWatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopWatchRequest proto.InternalMessageInfo
+
+func (m *StopWatchRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *StopWatchRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetStateRequest struct {
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	LayoutId             string   `protobuf:"bytes,2,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateRequest) Reset()         { *m = GetStateRequest{} }
+func (m *GetStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateRequest) ProtoMessage()    {}
+func (*GetStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{52}
+}
+
+func (m *GetStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateRequest.Unmarshal(m, b)
+}
+func (m *GetStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateRequest.Merge(m, src)
+}
+func (m *GetStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateRequest.Size(m)
+}
+func (m *GetStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateRequest proto.InternalMessageInfo
+
+func (m *GetStateRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *GetStateRequest) GetLayoutId() string {
+	if m != nil {
+		return m.LayoutId
+	}
+	return ""
+}
+
+type GetStateResponse struct {
+	State                []byte   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateResponse) Reset()         { *m = GetStateResponse{} }
+func (m *GetStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateResponse) ProtoMessage()    {}
+func (*GetStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{53}
+}
+
+func (m *GetStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateResponse.Unmarshal(m, b)
+}
+func (m *GetStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateResponse.Merge(m, src)
+}
+func (m *GetStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateResponse.Size(m)
+}
+func (m *GetStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateResponse proto.InternalMessageInfo
+
+func (m *GetStateResponse) GetState() []byte {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+type StateVersion struct {
+	Id                   string     `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	JobId                string     `protobuf:"bytes,2,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	Stage                StateStage `protobuf:"varint,3,opt,name=Stage,proto3,enum=tsocial.tessellate.server.StateStage" json:"Stage,omitempty"`
+	Serial               int64      `protobuf:"varint,4,opt,name=Serial,proto3" json:"Serial,omitempty"`
+	CreatedAt            int64      `protobuf:"varint,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	RestoredFrom         string     `protobuf:"bytes,6,opt,name=RestoredFrom,proto3" json:"RestoredFrom,omitempty"`
+	RestoredBy           string     `protobuf:"bytes,7,opt,name=RestoredBy,proto3" json:"RestoredBy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *StateVersion) Reset()         { *m = StateVersion{} }
+func (m *StateVersion) String() string { return proto.CompactTextString(m) }
+func (*StateVersion) ProtoMessage()    {}
+func (*StateVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{54}
+}
+
+func (m *StateVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateVersion.Unmarshal(m, b)
+}
+func (m *StateVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateVersion.Marshal(b, m, deterministic)
+}
+func (m *StateVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateVersion.Merge(m, src)
+}
+func (m *StateVersion) XXX_Size() int {
+	return xxx_messageInfo_StateVersion.Size(m)
+}
+func (m *StateVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateVersion proto.InternalMessageInfo
+
+func (m *StateVersion) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StateVersion) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *StateVersion) GetStage() StateStage {
+	if m != nil {
+		return m.Stage
+	}
+	return StateStage_BEFORE_JOB
+}
+
+func (m *StateVersion) GetSerial() int64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *StateVersion) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *StateVersion) GetRestoredFrom() string {
+	if m != nil {
+		return m.RestoredFrom
+	}
+	return ""
+}
+
+func (m *StateVersion) GetRestoredBy() string {
+	if m != nil {
+		return m.RestoredBy
+	}
+	return ""
+}
+
+type StateVersions struct {
+	Versions             []*StateVersion `protobuf:"bytes,1,rep,name=Versions,proto3" json:"Versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StateVersions) Reset()         { *m = StateVersions{} }
+func (m *StateVersions) String() string { return proto.CompactTextString(m) }
+func (*StateVersions) ProtoMessage()    {}
+func (*StateVersions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{55}
+}
+
+func (m *StateVersions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateVersions.Unmarshal(m, b)
+}
+func (m *StateVersions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateVersions.Marshal(b, m, deterministic)
+}
+func (m *StateVersions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateVersions.Merge(m, src)
+}
+func (m *StateVersions) XXX_Size() int {
+	return xxx_messageInfo_StateVersions.Size(m)
+}
+func (m *StateVersions) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateVersions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateVersions proto.InternalMessageInfo
+
+func (m *StateVersions) GetVersions() []*StateVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type LayoutLock struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	LayoutId    string `protobuf:"bytes,2,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	// Job the Lock is held for.
+	JobId                string   `protobuf:"bytes,3,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	LockedAt             int64    `protobuf:"varint,4,opt,name=LockedAt,proto3" json:"LockedAt,omitempty"`
+	AgeSeconds           int64    `protobuf:"varint,5,opt,name=AgeSeconds,proto3" json:"AgeSeconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LayoutLock) Reset()         { *m = LayoutLock{} }
+func (m *LayoutLock) String() string { return proto.CompactTextString(m) }
+func (*LayoutLock) ProtoMessage()    {}
+func (*LayoutLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{56}
+}
+
+func (m *LayoutLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LayoutLock.Unmarshal(m, b)
+}
+func (m *LayoutLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LayoutLock.Marshal(b, m, deterministic)
+}
+func (m *LayoutLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LayoutLock.Merge(m, src)
+}
+func (m *LayoutLock) XXX_Size() int {
+	return xxx_messageInfo_LayoutLock.Size(m)
+}
+func (m *LayoutLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_LayoutLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LayoutLock proto.InternalMessageInfo
+
+func (m *LayoutLock) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *LayoutLock) GetLayoutId() string {
+	if m != nil {
+		return m.LayoutId
+	}
+	return ""
+}
+
+func (m *LayoutLock) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *LayoutLock) GetLockedAt() int64 {
+	if m != nil {
+		return m.LockedAt
+	}
+	return 0
+}
+
+func (m *LayoutLock) GetAgeSeconds() int64 {
+	if m != nil {
+		return m.AgeSeconds
+	}
+	return 0
+}
+
+type ListLocksRequest struct {
+	// Only the Locks of this Workspace, all of them if empty.
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLocksRequest) Reset()         { *m = ListLocksRequest{} }
+func (m *ListLocksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLocksRequest) ProtoMessage()    {}
+func (*ListLocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{57}
+}
+
+func (m *ListLocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLocksRequest.Unmarshal(m, b)
+}
+func (m *ListLocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLocksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLocksRequest.Merge(m, src)
+}
+func (m *ListLocksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLocksRequest.Size(m)
+}
+func (m *ListLocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLocksRequest proto.InternalMessageInfo
+
+func (m *ListLocksRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+type LayoutLocks struct {
+	Locks                []*LayoutLock `protobuf:"bytes,1,rep,name=Locks,proto3" json:"Locks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *LayoutLocks) Reset()         { *m = LayoutLocks{} }
+func (m *LayoutLocks) String() string { return proto.CompactTextString(m) }
+func (*LayoutLocks) ProtoMessage()    {}
+func (*LayoutLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{58}
+}
+
+func (m *LayoutLocks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LayoutLocks.Unmarshal(m, b)
+}
+func (m *LayoutLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LayoutLocks.Marshal(b, m, deterministic)
+}
+func (m *LayoutLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LayoutLocks.Merge(m, src)
+}
+func (m *LayoutLocks) XXX_Size() int {
+	return xxx_messageInfo_LayoutLocks.Size(m)
+}
+func (m *LayoutLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_LayoutLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LayoutLocks proto.InternalMessageInfo
+
+func (m *LayoutLocks) GetLocks() []*LayoutLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+type ForceUnlockRequest struct {
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	LayoutId             string   `protobuf:"bytes,2,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForceUnlockRequest) Reset()         { *m = ForceUnlockRequest{} }
+func (m *ForceUnlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ForceUnlockRequest) ProtoMessage()    {}
+func (*ForceUnlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{59}
+}
+
+func (m *ForceUnlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceUnlockRequest.Unmarshal(m, b)
+}
+func (m *ForceUnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceUnlockRequest.Marshal(b, m, deterministic)
+}
+func (m *ForceUnlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceUnlockRequest.Merge(m, src)
+}
+func (m *ForceUnlockRequest) XXX_Size() int {
+	return xxx_messageInfo_ForceUnlockRequest.Size(m)
+}
+func (m *ForceUnlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceUnlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceUnlockRequest proto.InternalMessageInfo
+
+func (m *ForceUnlockRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *ForceUnlockRequest) GetLayoutId() string {
+	if m != nil {
+		return m.LayoutId
+	}
+	return ""
+}
+
+func (m *ForceUnlockRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Action               string   `protobuf:"bytes,2,opt,name=Action,proto3" json:"Action,omitempty"`
+	Actor                string   `protobuf:"bytes,3,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	WorkspaceId          string   `protobuf:"bytes,4,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	LayoutId             string   `protobuf:"bytes,5,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	Detail               string   `protobuf:"bytes,6,opt,name=Detail,proto3" json:"Detail,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{60}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetLayoutId() string {
+	if m != nil {
+		return m.LayoutId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type AuditEvents struct {
+	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditEvents) Reset()         { *m = AuditEvents{} }
+func (m *AuditEvents) String() string { return proto.CompactTextString(m) }
+func (*AuditEvents) ProtoMessage()    {}
+func (*AuditEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{61}
+}
+
+func (m *AuditEvents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvents.Unmarshal(m, b)
+}
+func (m *AuditEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvents.Marshal(b, m, deterministic)
+}
+func (m *AuditEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvents.Merge(m, src)
+}
+func (m *AuditEvents) XXX_Size() int {
+	return xxx_messageInfo_AuditEvents.Size(m)
+}
+func (m *AuditEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvents proto.InternalMessageInfo
+
+func (m *AuditEvents) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type ExportWorkspaceRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Base64 encoded 256 bit key to encrypt vars and state with.
+	// Without one they are redacted, and the archive cannot be imported.
+	Key                  string   `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportWorkspaceRequest) Reset()         { *m = ExportWorkspaceRequest{} }
+func (m *ExportWorkspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportWorkspaceRequest) ProtoMessage()    {}
+func (*ExportWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{62}
+}
+
+func (m *ExportWorkspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportWorkspaceRequest.Unmarshal(m, b)
+}
+func (m *ExportWorkspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportWorkspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportWorkspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportWorkspaceRequest.Merge(m, src)
+}
+func (m *ExportWorkspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportWorkspaceRequest.Size(m)
+}
+func (m *ExportWorkspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportWorkspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportWorkspaceRequest proto.InternalMessageInfo
+
+func (m *ExportWorkspaceRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExportWorkspaceRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ArchiveChunk struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveChunk) Reset()         { *m = ArchiveChunk{} }
+func (m *ArchiveChunk) String() string { return proto.CompactTextString(m) }
+func (*ArchiveChunk) ProtoMessage()    {}
+func (*ArchiveChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{63}
+}
+
+func (m *ArchiveChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveChunk.Unmarshal(m, b)
+}
+func (m *ArchiveChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveChunk.Marshal(b, m, deterministic)
+}
+func (m *ArchiveChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveChunk.Merge(m, src)
+}
+func (m *ArchiveChunk) XXX_Size() int {
+	return xxx_messageInfo_ArchiveChunk.Size(m)
+}
+func (m *ArchiveChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveChunk proto.InternalMessageInfo
+
+func (m *ArchiveChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// The first message names the Workspace and carries the Key, every message a chunk of the archive.
+type ImportWorkspaceRequest struct {
+	// Defaults to the name of the Workspace that was exported.
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportWorkspaceRequest) Reset()         { *m = ImportWorkspaceRequest{} }
+func (m *ImportWorkspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportWorkspaceRequest) ProtoMessage()    {}
+func (*ImportWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{64}
+}
+
+func (m *ImportWorkspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportWorkspaceRequest.Unmarshal(m, b)
+}
+func (m *ImportWorkspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportWorkspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportWorkspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportWorkspaceRequest.Merge(m, src)
+}
+func (m *ImportWorkspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportWorkspaceRequest.Size(m)
+}
+func (m *ImportWorkspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportWorkspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportWorkspaceRequest proto.InternalMessageInfo
+
+func (m *ImportWorkspaceRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *ImportWorkspaceRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ImportWorkspaceRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type StateVersionRequest struct {
+	WorkspaceId          string   `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	LayoutId             string   `protobuf:"bytes,2,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=Id,proto3" json:"Id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateVersionRequest) Reset()         { *m = StateVersionRequest{} }
+func (m *StateVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*StateVersionRequest) ProtoMessage()    {}
+func (*StateVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{65}
+}
+
+func (m *StateVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateVersionRequest.Unmarshal(m, b)
+}
+func (m *StateVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *StateVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateVersionRequest.Merge(m, src)
+}
+func (m *StateVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_StateVersionRequest.Size(m)
+}
+func (m *StateVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateVersionRequest proto.InternalMessageInfo
+
+func (m *StateVersionRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *StateVersionRequest) GetLayoutId() string {
+	if m != nil {
+		return m.LayoutId
+	}
+	return ""
+}
+
+func (m *StateVersionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetOutputRequest struct {
+	WorkspaceId string `protobuf:"bytes,1,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	LayoutId    string `protobuf:"bytes,2,opt,name=LayoutId,proto3" json:"LayoutId,omitempty"`
+	// Only the output of this name, all of them if empty.
+	Name                 string   `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOutputRequest) Reset()         { *m = GetOutputRequest{} }
+func (m *GetOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOutputRequest) ProtoMessage()    {}
+func (*GetOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{66}
+}
+
+func (m *GetOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOutputRequest.Unmarshal(m, b)
+}
+func (m *GetOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOutputRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOutputRequest.Merge(m, src)
+}
+func (m *GetOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOutputRequest.Size(m)
+}
+func (m *GetOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOutputRequest proto.InternalMessageInfo
+
+func (m *GetOutputRequest) GetWorkspaceId() string {
+	if m != nil {
+		return m.WorkspaceId
+	}
+	return ""
+}
+
+func (m *GetOutputRequest) GetLayoutId() string {
+	if m != nil {
+		return m.LayoutId
+	}
+	return ""
+}
+
+func (m *GetOutputRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetOutputResponse struct {
+	Output               []byte   `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOutputResponse) Reset()         { *m = GetOutputResponse{} }
+func (m *GetOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOutputResponse) ProtoMessage()    {}
+func (*GetOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f23e2eaca5ccbb15, []int{67}
+}
+
+func (m *GetOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOutputResponse.Unmarshal(m, b)
+}
+func (m *GetOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOutputResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOutputResponse.Merge(m, src)
+}
+func (m *GetOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOutputResponse.Size(m)
+}
+func (m *GetOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOutputResponse proto.InternalMessageInfo
+
+func (m *GetOutputResponse) GetOutput() []byte {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("tsocial.tessellate.server.Errors", Errors_name, Errors_value)
+	proto.RegisterEnum("tsocial.tessellate.server.Status", Status_name, Status_value)
+	proto.RegisterEnum("tsocial.tessellate.server.JobState", JobState_name, JobState_value)
+	proto.RegisterEnum("tsocial.tessellate.server.Action", Action_name, Action_value)
+	proto.RegisterEnum("tsocial.tessellate.server.Operation", Operation_name, Operation_value)
+	proto.RegisterEnum("tsocial.tessellate.server.FileChange", FileChange_name, FileChange_value)
+	proto.RegisterEnum("tsocial.tessellate.server.StateStage", StateStage_name, StateStage_value)
+	proto.RegisterType((*GetWorkspaceRequest)(nil), "tsocial.tessellate.server.GetWorkspaceRequest")
+	proto.RegisterType((*Workspace)(nil), "tsocial.tessellate.server.Workspace")
+	proto.RegisterType((*UpdateWorkspaceVarsRequest)(nil), "tsocial.tessellate.server.UpdateWorkspaceVarsRequest")
+	proto.RegisterType((*WorkspaceVarsVersion)(nil), "tsocial.tessellate.server.WorkspaceVarsVersion")
+	proto.RegisterType((*WorkspaceVarsVersions)(nil), "tsocial.tessellate.server.WorkspaceVarsVersions")
+	proto.RegisterType((*DiffWorkspaceVarsRequest)(nil), "tsocial.tessellate.server.DiffWorkspaceVarsRequest")
+	proto.RegisterType((*WorkspaceVarsDiff)(nil), "tsocial.tessellate.server.WorkspaceVarsDiff")
+	proto.RegisterType((*RestoreWorkspaceVarsRequest)(nil), "tsocial.tessellate.server.RestoreWorkspaceVarsRequest")
+	proto.RegisterType((*AllWorkspaces)(nil), "tsocial.tessellate.server.AllWorkspaces")
+	proto.RegisterType((*Layouts)(nil), "tsocial.tessellate.server.Layouts")
+	proto.RegisterType((*Layout)(nil), "tsocial.tessellate.server.Layout")
+	proto.RegisterType((*LayoutDrift)(nil), "tsocial.tessellate.server.LayoutDrift")
+	proto.RegisterType((*DiffLayoutVersionsRequest)(nil), "tsocial.tessellate.server.DiffLayoutVersionsRequest")
+	proto.RegisterType((*FileDiff)(nil), "tsocial.tessellate.server.FileDiff")
+	proto.RegisterType((*LayoutDiff)(nil), "tsocial.tessellate.server.LayoutDiff")
+	proto.RegisterType((*PromoteLayoutRequest)(nil), "tsocial.tessellate.server.PromoteLayoutRequest")
+	proto.RegisterType((*Promotion)(nil), "tsocial.tessellate.server.Promotion")
+	proto.RegisterType((*Promotions)(nil), "tsocial.tessellate.server.Promotions")
+	proto.RegisterType((*SaveWorkspaceRequest)(nil), "tsocial.tessellate.server.SaveWorkspaceRequest")
+	proto.RegisterType((*GetWorkspaceLayoutsRequest)(nil), "tsocial.tessellate.server.GetWorkspaceLayoutsRequest")
+	proto.RegisterType((*JobStatus)(nil), "tsocial.tessellate.server.JobStatus")
+	proto.RegisterType((*Job)(nil), "tsocial.tessellate.server.Job")
+	proto.RegisterType((*ListJobsRequest)(nil), "tsocial.tessellate.server.ListJobsRequest")
+	proto.RegisterType((*Jobs)(nil), "tsocial.tessellate.server.Jobs")
+	proto.RegisterType((*JobLog)(nil), "tsocial.tessellate.server.JobLog")
+	proto.RegisterType((*ResourceChange)(nil), "tsocial.tessellate.server.ResourceChange")
+	proto.RegisterType((*Plan)(nil), "tsocial.tessellate.server.Plan")
+	proto.RegisterType((*Vars)(nil), "tsocial.tessellate.server.Vars")
+	proto.RegisterType((*JobRequest)(nil), "tsocial.tessellate.server.JobRequest")
+	proto.RegisterType((*Ok)(nil), "tsocial.tessellate.server.Ok")
+	proto.RegisterType((*LayoutRequest)(nil), "tsocial.tessellate.server.LayoutRequest")
+	proto.RegisterType((*SaveLayoutRequest)(nil), "tsocial.tessellate.server.SaveLayoutRequest")
+	proto.RegisterType((*SaveLayoutResponse)(nil), "tsocial.tessellate.server.SaveLayoutResponse")
+	proto.RegisterType((*SetLayoutStatusRequest)(nil), "tsocial.tessellate.server.SetLayoutStatusRequest")
+	proto.RegisterType((*ApplyLayoutRequest)(nil), "tsocial.tessellate.server.ApplyLayoutRequest")
+	proto.RegisterType((*RollbackLayoutRequest)(nil), "tsocial.tessellate.server.RollbackLayoutRequest")
+	proto.RegisterType((*DestroyLayoutRequest)(nil), "tsocial.tessellate.server.DestroyLayoutRequest")
+	proto.RegisterType((*DeleteLayoutRequest)(nil), "tsocial.tessellate.server.DeleteLayoutRequest")
+	proto.RegisterType((*DeleteWorkspaceRequest)(nil), "tsocial.tessellate.server.DeleteWorkspaceRequest")
+	proto.RegisterType((*StackLayout)(nil), "tsocial.tessellate.server.StackLayout")
+	proto.RegisterType((*SaveStackRequest)(nil), "tsocial.tessellate.server.SaveStackRequest")
+	proto.RegisterType((*StackRequest)(nil), "tsocial.tessellate.server.StackRequest")
+	proto.RegisterType((*StackLayer)(nil), "tsocial.tessellate.server.StackLayer")
+	proto.RegisterType((*Stack)(nil), "tsocial.tessellate.server.Stack")
+	proto.RegisterType((*StackRunRequest)(nil), "tsocial.tessellate.server.StackRunRequest")
+	proto.RegisterType((*StackJob)(nil), "tsocial.tessellate.server.StackJob")
+	proto.RegisterType((*StackRun)(nil), "tsocial.tessellate.server.StackRun")
+	proto.RegisterType((*RefreshLayoutRequest)(nil), "tsocial.tessellate.server.RefreshLayoutRequest")
+	proto.RegisterType((*ImportResourceRequest)(nil), "tsocial.tessellate.server.ImportResourceRequest")
+	proto.RegisterType((*StartWatchRequest)(nil), "tsocial.tessellate.server.StartWatchRequest")
+	proto.RegisterType((*DriftScheduleRequest)(nil), "tsocial.tessellate.server.DriftScheduleRequest")
+	proto.RegisterType((*StopWatchRequest)(nil), "tsocial.tessellate.server.StopWatchRequest")
+	proto.RegisterType((*GetStateRequest)(nil), "tsocial.tessellate.server.GetStateRequest")
+	proto.RegisterType((*GetStateResponse)(nil), "tsocial.tessellate.server.GetStateResponse")
+	proto.RegisterType((*StateVersion)(nil), "tsocial.tessellate.server.StateVersion")
+	proto.RegisterType((*StateVersions)(nil), "tsocial.tessellate.server.StateVersions")
+	proto.RegisterType((*LayoutLock)(nil), "tsocial.tessellate.server.LayoutLock")
+	proto.RegisterType((*ListLocksRequest)(nil), "tsocial.tessellate.server.ListLocksRequest")
+	proto.RegisterType((*LayoutLocks)(nil), "tsocial.tessellate.server.LayoutLocks")
+	proto.RegisterType((*ForceUnlockRequest)(nil), "tsocial.tessellate.server.ForceUnlockRequest")
+	proto.RegisterType((*AuditEvent)(nil), "tsocial.tessellate.server.AuditEvent")
+	proto.RegisterType((*AuditEvents)(nil), "tsocial.tessellate.server.AuditEvents")
+	proto.RegisterType((*ExportWorkspaceRequest)(nil), "tsocial.tessellate.server.ExportWorkspaceRequest")
+	proto.RegisterType((*ArchiveChunk)(nil), "tsocial.tessellate.server.ArchiveChunk")
+	proto.RegisterType((*ImportWorkspaceRequest)(nil), "tsocial.tessellate.server.ImportWorkspaceRequest")
+	proto.RegisterType((*StateVersionRequest)(nil), "tsocial.tessellate.server.StateVersionRequest")
+	proto.RegisterType((*GetOutputRequest)(nil), "tsocial.tessellate.server.GetOutputRequest")
+	proto.RegisterType((*GetOutputResponse)(nil), "tsocial.tessellate.server.GetOutputResponse")
+}
+
+func init() { proto.RegisterFile("proto/tessellate.proto", fileDescriptor_f23e2eaca5ccbb15) }
+
+var fileDescriptor_f23e2eaca5ccbb15 = []byte{
+	// 3654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0xcb, 0x6f, 0x1b, 0xc7,
+	0xf9, 0x5c, 0xbe, 0x44, 0x7e, 0x7a, 0x51, 0x63, 0x59, 0x61, 0x18, 0x27, 0x91, 0x27, 0x7e, 0x48,
+	0xb2, 0x2d, 0xda, 0x72, 0xfc, 0xcb, 0xcf, 0x79, 0xc0, 0x25, 0x45, 0x4a, 0xa1, 0x42, 0x8b, 0xca,
+	0x92, 0xb2, 0xe1, 0x5a, 0xb2, 0xbd, 0x22, 0xc7, 0x12, 0x23, 0x8a, 0xcb, 0xec, 0x2e, 0x15, 0x2b,
+	0x4d, 0xd2, 0xa6, 0x45, 0x0f, 0x05, 0x5a, 0xb4, 0x70, 0x52, 0xb4, 0x40, 0x03, 0x14, 0x28, 0xd0,
+	0x53, 0xff, 0x87, 0xde, 0x7a, 0xea, 0xad, 0xbd, 0xf4, 0xde, 0xde, 0xfa, 0x07, 0x14, 0xf0, 0xa9,
+	0x98, 0xc7, 0xbe, 0xc8, 0xd5, 0x72, 0xe9, 0xca, 0xe9, 0xa5, 0x27, 0xee, 0x7c, 0x33, 0xf3, 0xcd,
+	0x37, 0xdf, 0x7b, 0xbe, 0x19, 0xc2, 0x4c, 0x47, 0x53, 0x0d, 0x35, 0x6b, 0x10, 0x5d, 0x27, 0xad,
+	0x96, 0x62, 0x90, 0x45, 0x06, 0x40, 0x2f, 0x1b, 0xba, 0x5a, 0x6f, 0x2a, 0xad, 0x45, 0x47, 0x8f,
+	0x4e, 0xb4, 0x43, 0xa2, 0x65, 0xce, 0xec, 0xaa, 0xea, 0x6e, 0x8b, 0x64, 0x95, 0x4e, 0x33, 0xab,
+	0xb4, 0xdb, 0xaa, 0xa1, 0x18, 0x4d, 0xb5, 0xad, 0xf3, 0x89, 0x99, 0xdc, 0x6e, 0xd3, 0xd8, 0xeb,
+	0xee, 0x2c, 0xd6, 0xd5, 0x83, 0x2c, 0x69, 0x1f, 0xaa, 0x47, 0x1d, 0x4d, 0x7d, 0x72, 0x94, 0x65,
+	0x9d, 0xf5, 0x2b, 0xbb, 0xa4, 0x7d, 0xe5, 0x50, 0x69, 0x35, 0x1b, 0x8a, 0x41, 0xb2, 0x7d, 0x1f,
+	0x1c, 0x05, 0x5e, 0x84, 0x53, 0xab, 0xc4, 0xb8, 0xab, 0x6a, 0xfb, 0x7a, 0x47, 0xa9, 0x13, 0x99,
+	0x7c, 0xdc, 0x25, 0xba, 0x81, 0x5e, 0x82, 0x70, 0xa9, 0x91, 0x96, 0x66, 0xa5, 0xb9, 0x64, 0x7e,
+	0xe4, 0x59, 0x3e, 0xaa, 0x85, 0x53, 0x92, 0x1c, 0x2e, 0x35, 0x70, 0x13, 0x92, 0xd6, 0x60, 0x84,
+	0x20, 0xba, 0xae, 0x1c, 0x10, 0x3e, 0x4e, 0x66, 0xdf, 0x14, 0x76, 0x47, 0xd1, 0xf4, 0x74, 0x78,
+	0x56, 0x9a, 0x1b, 0x93, 0xd9, 0x37, 0x4a, 0xc3, 0xc8, 0x1d, 0xa2, 0xe9, 0x4d, 0xb5, 0x9d, 0x8e,
+	0xb0, 0xa1, 0x66, 0x13, 0x65, 0x20, 0x21, 0x3e, 0xf5, 0x74, 0x74, 0x36, 0x32, 0x97, 0x94, 0xad,
+	0x36, 0x96, 0x21, 0xb3, 0xd9, 0xa1, 0xa4, 0x5a, 0x0b, 0x52, 0x64, 0x83, 0x28, 0x44, 0xaf, 0x38,
+	0x09, 0x60, 0x5d, 0x9f, 0xd2, 0x2e, 0x06, 0xc4, 0x17, 0x60, 0xda, 0x85, 0xcd, 0xa4, 0x63, 0xc2,
+	0xc6, 0xc6, 0xb6, 0x79, 0x1d, 0x4e, 0x7b, 0x8d, 0xd3, 0x5d, 0x04, 0x4b, 0x3d, 0x04, 0xdf, 0x85,
+	0x74, 0xa1, 0xf9, 0xf8, 0xf1, 0x70, 0xe4, 0x22, 0x88, 0xae, 0x68, 0xea, 0x01, 0x23, 0x37, 0x29,
+	0xb3, 0x6f, 0x4a, 0x4d, 0x4d, 0x15, 0xac, 0x0a, 0xd7, 0x54, 0xfc, 0xa5, 0x04, 0x53, 0x2e, 0xac,
+	0x74, 0x19, 0x6b, 0xa6, 0xd4, 0x37, 0x33, 0x6c, 0xce, 0x44, 0xd3, 0x10, 0xdb, 0x50, 0x8c, 0x3d,
+	0x3d, 0x1d, 0x61, 0xb4, 0xf2, 0x06, 0xdd, 0x04, 0x1d, 0xcd, 0xd8, 0x14, 0x65, 0x72, 0xb2, 0xda,
+	0x68, 0x06, 0xe2, 0x35, 0x95, 0xf5, 0xc4, 0x58, 0x8f, 0x68, 0xe1, 0x7b, 0xf0, 0x8a, 0x4c, 0x74,
+	0x43, 0xd5, 0x86, 0x14, 0xc7, 0x59, 0x5b, 0xf6, 0x61, 0x77, 0xaf, 0x09, 0xc7, 0x9b, 0x30, 0x9e,
+	0x6b, 0xb5, 0x2c, 0xb4, 0x3a, 0x2a, 0x00, 0xd8, 0x2d, 0xc6, 0xe6, 0xd1, 0xa5, 0x73, 0x8b, 0xc7,
+	0x5a, 0xc9, 0xa2, 0xad, 0xbe, 0x8e, 0x79, 0x78, 0x05, 0x46, 0xca, 0xca, 0x91, 0xda, 0x35, 0x74,
+	0xf4, 0x0e, 0x8c, 0xb4, 0xf8, 0xa7, 0xc0, 0x76, 0xd6, 0x07, 0x1b, 0x9f, 0x24, 0x9b, 0x33, 0xf0,
+	0x6f, 0xc2, 0x10, 0xe7, 0x30, 0x34, 0x0b, 0xa3, 0xd6, 0x02, 0x4d, 0x53, 0x5f, 0x9c, 0x20, 0xa1,
+	0x48, 0x61, 0x53, 0x91, 0xa8, 0x90, 0x36, 0x5a, 0x0a, 0xd7, 0xfb, 0x31, 0x99, 0x7d, 0xa3, 0x9b,
+	0x10, 0xaf, 0x1a, 0x8a, 0xd1, 0xe5, 0x2c, 0x9e, 0xf0, 0x25, 0x86, 0x0f, 0x94, 0xc5, 0x04, 0xf4,
+	0x2e, 0xc4, 0x0a, 0x5a, 0xf3, 0xb1, 0x91, 0x8e, 0xcf, 0x4a, 0x73, 0xa3, 0x4b, 0x17, 0x06, 0x6e,
+	0x83, 0x8d, 0x96, 0xf9, 0x24, 0x74, 0x19, 0xa6, 0xaa, 0x6a, 0x57, 0xab, 0xdb, 0x22, 0x2c, 0x35,
+	0xd2, 0x23, 0x8c, 0xd6, 0xfe, 0x0e, 0x74, 0x0e, 0xc6, 0x39, 0xd0, 0x94, 0x5f, 0x82, 0x8d, 0x74,
+	0x03, 0xf1, 0x2f, 0x25, 0x18, 0x75, 0x2c, 0x45, 0x75, 0xab, 0x5a, 0xdf, 0x23, 0x8d, 0x6e, 0xcb,
+	0xf4, 0x0b, 0x56, 0x9b, 0x6a, 0xe3, 0x9a, 0xba, 0x63, 0xf1, 0x87, 0x37, 0xa8, 0x77, 0x60, 0x53,
+	0x49, 0x83, 0x71, 0x29, 0x21, 0x9b, 0x4d, 0x74, 0x06, 0x92, 0x32, 0xd1, 0xd9, 0x72, 0xa6, 0x7b,
+	0xb0, 0x01, 0xb4, 0x77, 0x79, 0x8f, 0xd4, 0xf7, 0x49, 0x23, 0x67, 0x30, 0x4e, 0x46, 0x64, 0x1b,
+	0x80, 0x7f, 0x24, 0xc1, 0xcb, 0xd4, 0x4c, 0x38, 0x6d, 0xa6, 0x8d, 0x9a, 0xea, 0x3a, 0xef, 0x10,
+	0x64, 0xbf, 0xde, 0x3a, 0xfb, 0x84, 0x66, 0x87, 0x8f, 0xb7, 0xdc, 0x48, 0x9f, 0xfd, 0x45, 0x2d,
+	0xcb, 0xd5, 0x21, 0xb1, 0xd2, 0x6c, 0x11, 0xd3, 0x5e, 0xfb, 0xbc, 0xe5, 0x7b, 0x10, 0x5f, 0xde,
+	0x53, 0xda, 0xbb, 0x84, 0x2d, 0x30, 0xb1, 0x74, 0xde, 0x47, 0xa0, 0x14, 0x11, 0x1f, 0x2c, 0x8b,
+	0x49, 0xde, 0xe6, 0x8d, 0xf7, 0x01, 0x84, 0x44, 0x82, 0xba, 0x89, 0x9b, 0x10, 0xa3, 0xd8, 0x39,
+	0x9e, 0xd1, 0xa5, 0x37, 0x06, 0x50, 0x41, 0xf1, 0xca, 0x7c, 0x06, 0xfe, 0x8b, 0x04, 0xd3, 0x1b,
+	0x9a, 0x7a, 0xa0, 0x1a, 0x44, 0x18, 0x8e, 0x60, 0xf1, 0x0d, 0x2f, 0x65, 0xeb, 0x61, 0x74, 0xff,
+	0x88, 0xe3, 0xd9, 0x7d, 0xbd, 0x27, 0x88, 0xe4, 0x5f, 0x7e, 0x96, 0x9f, 0xd1, 0xa6, 0x97, 0xd0,
+	0x83, 0xb9, 0xfb, 0x57, 0xaf, 0xdc, 0xdc, 0xbe, 0xf4, 0x19, 0x25, 0x53, 0x37, 0xe6, 0x6f, 0x9d,
+	0xb3, 0xe3, 0xcb, 0x0d, 0x98, 0xaa, 0x29, 0xda, 0xae, 0x23, 0xc2, 0x95, 0x1a, 0xe9, 0xa8, 0x1b,
+	0x79, 0xff, 0x08, 0xfc, 0x55, 0x18, 0x92, 0x7c, 0x53, 0x1e, 0xc1, 0x81, 0xaa, 0x38, 0xdf, 0xaa,
+	0xa5, 0xc9, 0x56, 0xdb, 0xdb, 0xc4, 0x22, 0x81, 0x4d, 0x2c, 0xea, 0x61, 0x62, 0x14, 0x67, 0xff,
+	0x26, 0x62, 0x1c, 0x67, 0x5f, 0x87, 0x33, 0xd8, 0xc6, 0xdd, 0xc1, 0xf6, 0x35, 0x00, 0x21, 0xa9,
+	0x46, 0xfe, 0x48, 0xd8, 0xbd, 0x03, 0xe2, 0xec, 0xcf, 0x19, 0xcc, 0xda, 0x23, 0xb2, 0x03, 0x82,
+	0xbf, 0x09, 0x9b, 0x03, 0x58, 0x28, 0x2c, 0x38, 0x5b, 0x01, 0xbc, 0xb4, 0x35, 0x58, 0x76, 0x62,
+	0xb9, 0x00, 0x13, 0xb9, 0x4e, 0xa7, 0xd5, 0x24, 0x0d, 0x57, 0x98, 0x90, 0x7b, 0xa0, 0xe8, 0x6d,
+	0x48, 0x0b, 0xc8, 0x71, 0xfc, 0x3d, 0xb6, 0x1f, 0x2d, 0xc1, 0xb4, 0xab, 0xcf, 0xcd, 0x6d, 0xcf,
+	0x3e, 0x74, 0x15, 0x4e, 0x95, 0x99, 0x3e, 0xb9, 0xa7, 0x70, 0xb6, 0x7b, 0x75, 0xe1, 0xdb, 0x30,
+	0x5d, 0x55, 0x0e, 0x49, 0xe0, 0x5c, 0x8a, 0x3a, 0xb0, 0x0d, 0x4d, 0x3d, 0x6c, 0x36, 0x88, 0x95,
+	0x2f, 0xd9, 0x00, 0x7c, 0x03, 0x32, 0xce, 0xcc, 0x4c, 0x84, 0xb2, 0x81, 0x09, 0xda, 0x53, 0x09,
+	0x92, 0x6b, 0xea, 0x8e, 0x88, 0x17, 0xbd, 0xaa, 0xfb, 0x0e, 0xc4, 0x75, 0xd6, 0x23, 0xfc, 0x8d,
+	0x9f, 0xa5, 0x0b, 0x2c, 0x44, 0x16, 0x53, 0xa8, 0x27, 0x29, 0x37, 0xdb, 0xfb, 0x82, 0x07, 0xec,
+	0x9b, 0x6a, 0xf0, 0x87, 0x5d, 0xd2, 0x25, 0x1b, 0xaa, 0xde, 0x34, 0x4c, 0x9d, 0x8b, 0xc9, 0x6e,
+	0x20, 0xfe, 0x3a, 0x06, 0x91, 0x35, 0x75, 0xa7, 0x8f, 0x9c, 0x59, 0xb7, 0x1b, 0x0e, 0xf7, 0xc4,
+	0xd3, 0x1e, 0x5b, 0x8b, 0xf4, 0xd8, 0xda, 0x39, 0x18, 0x77, 0x79, 0x77, 0xd3, 0x7a, 0x5c, 0x40,
+	0xba, 0x86, 0x23, 0x83, 0x13, 0xc4, 0x3b, 0x41, 0xe8, 0x4d, 0x08, 0x57, 0x3a, 0x8c, 0xf0, 0x09,
+	0x5f, 0x05, 0xae, 0x74, 0x88, 0xc6, 0xf2, 0x6f, 0x39, 0x5c, 0xe9, 0xa0, 0x14, 0x44, 0x0a, 0x1a,
+	0x37, 0xa3, 0x84, 0x4c, 0x3f, 0xa9, 0x37, 0x96, 0x89, 0xa1, 0x1d, 0x09, 0xd3, 0xe1, 0x0d, 0xca,
+	0x72, 0x11, 0xed, 0x93, 0x43, 0xb0, 0x5c, 0xc8, 0x6f, 0x1a, 0x62, 0x45, 0x4d, 0x53, 0xb5, 0x34,
+	0xf0, 0x88, 0xc9, 0x1a, 0x54, 0x71, 0xaa, 0x86, 0xa2, 0x71, 0x3b, 0x1d, 0xe5, 0x91, 0xcf, 0x02,
+	0x50, 0x07, 0x50, 0x6c, 0x37, 0x58, 0xdf, 0x18, 0xeb, 0x33, 0x9b, 0x2c, 0x62, 0x6a, 0x44, 0xe1,
+	0xf3, 0xc6, 0x45, 0xc4, 0x34, 0x01, 0x4c, 0x1d, 0x5b, 0x4a, 0x9b, 0x47, 0xe8, 0x09, 0xb6, 0x9e,
+	0x0d, 0xa0, 0x6c, 0x64, 0xa4, 0x55, 0x89, 0xd6, 0x54, 0x5a, 0xe9, 0x49, 0x36, 0xdb, 0x09, 0xea,
+	0x57, 0x85, 0x94, 0x87, 0x2a, 0xd0, 0x1d, 0xf1, 0x0c, 0x66, 0x8a, 0x31, 0x8e, 0x37, 0x28, 0xcd,
+	0xdc, 0x93, 0xe9, 0x69, 0xc4, 0x42, 0x99, 0xd9, 0xa4, 0x3d, 0x32, 0xe9, 0xb4, 0x94, 0x3a, 0x49,
+	0x9f, 0xe2, 0x3d, 0xa2, 0x49, 0xd7, 0x2b, 0x1d, 0x74, 0x54, 0xcd, 0xc8, 0x35, 0x1a, 0x1a, 0xd1,
+	0xf5, 0xf4, 0x34, 0x17, 0xbf, 0x0b, 0x48, 0x15, 0x88, 0x03, 0x4a, 0x8d, 0xf4, 0x69, 0xae, 0x40,
+	0x66, 0x1b, 0xff, 0x5d, 0x82, 0xc9, 0x72, 0x53, 0x37, 0xd6, 0xd4, 0x9d, 0xe7, 0xc9, 0x0c, 0xde,
+	0xe8, 0x8d, 0x03, 0xf6, 0x38, 0xab, 0xc3, 0x14, 0xbf, 0x88, 0xad, 0xc3, 0x88, 0x9f, 0xa7, 0x38,
+	0x1b, 0xca, 0x2e, 0xa9, 0xa9, 0xfb, 0xc4, 0xd4, 0x6e, 0x1b, 0x80, 0xce, 0x43, 0x82, 0x36, 0xaa,
+	0xcd, 0x4f, 0x09, 0x53, 0xeb, 0x58, 0x3e, 0xf9, 0x2c, 0x1f, 0xcf, 0x44, 0xd3, 0x8d, 0xb9, 0x90,
+	0x6c, 0x75, 0xe1, 0x47, 0x10, 0xa5, 0x1b, 0x44, 0x4b, 0xfc, 0x57, 0x78, 0xea, 0xd7, 0xfc, 0xe9,
+	0x90, 0xf9, 0x9c, 0x73, 0x30, 0xbe, 0x4e, 0x9e, 0x18, 0x36, 0x11, 0xdc, 0x44, 0xdd, 0x40, 0x7c,
+	0x06, 0xe2, 0x6b, 0xea, 0x4e, 0x59, 0xdd, 0xa5, 0x2e, 0xa2, 0xa0, 0x18, 0x0a, 0x63, 0xdb, 0x98,
+	0xcc, 0xbe, 0xf1, 0xcf, 0x24, 0x98, 0x30, 0xb3, 0x36, 0x91, 0xb7, 0xa4, 0x61, 0xc4, 0x14, 0x1a,
+	0x77, 0x06, 0x66, 0x93, 0x22, 0xa8, 0x1d, 0x75, 0x88, 0x79, 0x1c, 0xa2, 0xdf, 0x56, 0xe2, 0x14,
+	0x71, 0x24, 0x4e, 0x37, 0x21, 0x9e, 0xab, 0x1b, 0xa6, 0xd1, 0xfb, 0xe7, 0xd0, 0x7c, 0xa0, 0x2c,
+	0x26, 0xe0, 0xdf, 0x4b, 0x3c, 0x27, 0xb7, 0xd3, 0x51, 0xc9, 0x99, 0x8e, 0x2e, 0xc3, 0x08, 0xa7,
+	0x92, 0xfa, 0x48, 0xca, 0xa9, 0x79, 0x1f, 0xd4, 0xee, 0x7d, 0xc9, 0xe6, 0x4c, 0xea, 0x1c, 0x72,
+	0x0d, 0xee, 0xb1, 0x62, 0x32, 0xfd, 0xa4, 0xe7, 0x2a, 0xde, 0xc9, 0x08, 0x8e, 0x59, 0x29, 0x1c,
+	0xcd, 0x7e, 0x89, 0x6e, 0x68, 0xea, 0x11, 0x97, 0xa1, 0x6c, 0x36, 0x71, 0x86, 0x1f, 0x64, 0xad,
+	0x13, 0xb5, 0x64, 0x9f, 0xa8, 0x71, 0x17, 0x80, 0x0a, 0x69, 0x50, 0x84, 0x99, 0xf7, 0xf0, 0xaf,
+	0x01, 0x94, 0x39, 0x72, 0x8c, 0x32, 0xe3, 0x28, 0x84, 0x2b, 0xfb, 0xb8, 0x6a, 0xfa, 0xdd, 0x13,
+	0xcc, 0xa6, 0xf1, 0xe7, 0x30, 0x45, 0xa3, 0xe7, 0x89, 0x23, 0xf6, 0x3c, 0x81, 0x09, 0xdf, 0x1d,
+	0xb5, 0x7c, 0x37, 0xbe, 0x0a, 0xc8, 0xb9, 0xbc, 0xde, 0x51, 0xdb, 0x3a, 0x71, 0x45, 0x1f, 0xc9,
+	0x1d, 0x7d, 0xb0, 0x01, 0x33, 0x55, 0x62, 0xf0, 0xa6, 0x38, 0xa5, 0x9d, 0x20, 0xd5, 0x33, 0x56,
+	0xd4, 0xe0, 0x5a, 0x2f, 0x5a, 0xf8, 0x4f, 0x11, 0x40, 0x34, 0x5f, 0x39, 0x7a, 0x21, 0x8c, 0x62,
+	0x7a, 0x16, 0x71, 0x54, 0x6e, 0x52, 0x10, 0x69, 0xd8, 0x8c, 0x6a, 0x68, 0x47, 0xe8, 0x55, 0x33,
+	0xc8, 0xb1, 0x13, 0x17, 0xc3, 0x80, 0xc3, 0x73, 0x21, 0x33, 0xda, 0xb9, 0x82, 0x48, 0xbc, 0x37,
+	0x88, 0x7c, 0x6e, 0xbb, 0xf9, 0x11, 0xea, 0xcc, 0xf3, 0xf5, 0x67, 0xf9, 0x47, 0x4f, 0xa5, 0x6d,
+	0x7c, 0x5f, 0xbb, 0xb7, 0x74, 0xf7, 0xc1, 0xdc, 0x81, 0x4a, 0xcf, 0x89, 0x5b, 0x8b, 0xf7, 0xb7,
+	0x3e, 0xb9, 0xb2, 0x7d, 0x69, 0x6e, 0xeb, 0xfe, 0xfd, 0x07, 0x5b, 0xdb, 0xdb, 0x97, 0xb6, 0xb6,
+	0xe7, 0x6f, 0x6d, 0x2d, 0xce, 0x2f, 0xf4, 0xf4, 0x7f, 0x36, 0xd7, 0x50, 0x0c, 0x65, 0x6b, 0x71,
+	0xfe, 0x16, 0x6f, 0x9b, 0xf0, 0x79, 0xd7, 0xc4, 0x73, 0x76, 0x2c, 0xa9, 0xdb, 0xb1, 0x24, 0xc1,
+	0x96, 0x2f, 0x3d, 0xcb, 0xaf, 0x3c, 0x95, 0x96, 0x71, 0x4e, 0xbb, 0xb5, 0xf4, 0xde, 0xc0, 0xe5,
+	0xdd, 0xab, 0xf4, 0x2e, 0xe2, 0x08, 0x4b, 0xee, 0xac, 0x24, 0xe9, 0x91, 0x95, 0xe0, 0x5f, 0x48,
+	0x70, 0x5a, 0x56, 0x5b, 0xad, 0x1d, 0xa5, 0xbe, 0x7f, 0xf2, 0xa2, 0xb4, 0x3c, 0x5b, 0xc4, 0xe9,
+	0xd9, 0x2c, 0xd1, 0x45, 0xbd, 0x44, 0x87, 0x7f, 0x1a, 0x86, 0x69, 0xe1, 0x7b, 0xbe, 0x1d, 0xe5,
+	0xf2, 0xa7, 0xc7, 0xa9, 0x2c, 0xb1, 0x6f, 0x5f, 0x59, 0xb0, 0x0a, 0xa7, 0x0a, 0xa4, 0x45, 0x0c,
+	0xf2, 0x42, 0xc4, 0xb3, 0xa2, 0x6a, 0x75, 0x22, 0xea, 0x1d, 0xbc, 0x81, 0xaf, 0xc1, 0x0c, 0x5f,
+	0x30, 0x78, 0x35, 0xb6, 0xc0, 0x92, 0x32, 0x53, 0x83, 0x7c, 0x4f, 0x1a, 0x05, 0xd2, 0x21, 0xed,
+	0x86, 0x5e, 0x69, 0xb3, 0xa8, 0x96, 0x94, 0x6d, 0x00, 0xfe, 0x9d, 0x04, 0x29, 0xea, 0xfc, 0x18,
+	0xaa, 0x93, 0xdc, 0xe7, 0x9a, 0x55, 0x81, 0x13, 0x85, 0x85, 0x0b, 0xfe, 0x95, 0x2e, 0x73, 0x23,
+	0xf9, 0xc4, 0xb3, 0x7c, 0xec, 0xa9, 0x14, 0x4e, 0x48, 0xb2, 0x89, 0x00, 0x7f, 0x0c, 0x63, 0x27,
+	0x4e, 0x9f, 0xa5, 0x80, 0x11, 0x4f, 0x83, 0x58, 0x00, 0x30, 0x89, 0x22, 0x2c, 0xe9, 0x36, 0x7d,
+	0xbf, 0x59, 0xfa, 0xb5, 0x01, 0xf8, 0x6f, 0x12, 0xc4, 0xd8, 0x60, 0x34, 0xeb, 0x41, 0x98, 0x9b,
+	0x9e, 0xde, 0x1a, 0xe1, 0x77, 0x9e, 0x93, 0x4d, 0x16, 0x73, 0x68, 0x19, 0x89, 0x11, 0xc9, 0xab,
+	0x64, 0xa3, 0x4b, 0xe7, 0x03, 0x20, 0x20, 0x9a, 0x2c, 0x26, 0xf1, 0xad, 0xe9, 0x86, 0xdc, 0x6d,
+	0x5b, 0x85, 0x05, 0x1b, 0x80, 0x55, 0x98, 0xe4, 0x9c, 0xef, 0xb6, 0x9f, 0x83, 0xf9, 0x67, 0x61,
+	0x84, 0xcd, 0xee, 0x97, 0x80, 0x09, 0x17, 0xfc, 0x88, 0x58, 0xc5, 0xf7, 0x9f, 0x4b, 0x90, 0x60,
+	0x7d, 0xf4, 0xc8, 0xe8, 0x13, 0x82, 0x8f, 0xa9, 0x27, 0xbe, 0xe3, 0x0a, 0x9d, 0xc3, 0x1f, 0xb8,
+	0x18, 0x53, 0x44, 0x96, 0xc6, 0x1b, 0xf8, 0xcf, 0x61, 0x41, 0x91, 0xdc, 0xed, 0x2f, 0x07, 0xa5,
+	0x7b, 0x76, 0x68, 0x6f, 0x8c, 0x1f, 0x2c, 0x23, 0x43, 0x1e, 0x2c, 0x6d, 0xfa, 0xa3, 0xff, 0x01,
+	0xfd, 0x31, 0x07, 0xfd, 0x0e, 0xfd, 0x88, 0x3f, 0x8f, 0x7e, 0xbc, 0x25, 0x4e, 0x0e, 0x23, 0x03,
+	0xab, 0x83, 0xa6, 0xd8, 0xc4, 0xf1, 0xc1, 0x3a, 0xbe, 0x26, 0x1c, 0xc7, 0x57, 0x16, 0x68, 0x64,
+	0xf2, 0x58, 0x23, 0xfa, 0xde, 0xff, 0x02, 0x8d, 0x8e, 0x7f, 0x15, 0x86, 0xd3, 0xfc, 0x48, 0x6a,
+	0x9e, 0x26, 0xfe, 0xcb, 0xfc, 0x78, 0x60, 0x9f, 0xce, 0x98, 0x33, 0xc8, 0x17, 0x9e, 0xe5, 0x4f,
+	0x22, 0x43, 0x12, 0x48, 0xd1, 0x45, 0x00, 0x73, 0xa7, 0x66, 0x92, 0x68, 0xd3, 0xec, 0xe8, 0xc2,
+	0x7f, 0x90, 0x60, 0x8a, 0xd5, 0x35, 0xee, 0x2a, 0x46, 0x7d, 0xef, 0x24, 0xb9, 0x32, 0x07, 0x93,
+	0xd5, 0x6e, 0xbd, 0x4e, 0x74, 0x7d, 0x59, 0xe1, 0x49, 0x98, 0xf0, 0x3f, 0xbd, 0x60, 0x3a, 0x72,
+	0x45, 0x69, 0xb6, 0xba, 0x1a, 0xb1, 0x46, 0xf2, 0x83, 0x78, 0x2f, 0x18, 0xb7, 0x61, 0x9a, 0x15,
+	0x33, 0xcc, 0xeb, 0x8e, 0x13, 0x96, 0xe2, 0xb2, 0x66, 0x5d, 0x9f, 0xb2, 0x6f, 0x7c, 0x07, 0x52,
+	0x55, 0x43, 0xed, 0x9c, 0x34, 0x6f, 0xb0, 0x02, 0x93, 0xab, 0xc4, 0xe0, 0x6e, 0xe5, 0xc5, 0x14,
+	0x45, 0xf0, 0x1c, 0xa4, 0xec, 0x25, 0xc4, 0x59, 0x6b, 0x1a, 0x62, 0x3a, 0x05, 0x88, 0x73, 0x2e,
+	0x6f, 0xe0, 0x7f, 0x4a, 0x2c, 0xee, 0x1b, 0xe4, 0x98, 0x9b, 0xda, 0x63, 0x63, 0x00, 0x8d, 0xc6,
+	0xbb, 0x44, 0x38, 0xdf, 0x01, 0xfe, 0xce, 0x20, 0x6c, 0xb0, 0xcc, 0xe7, 0xb0, 0xb3, 0x17, 0xaf,
+	0x72, 0x31, 0xfb, 0x90, 0x45, 0xcb, 0x5d, 0x3e, 0x8b, 0xf5, 0x96, 0xcf, 0x30, 0x8c, 0x89, 0x0b,
+	0xd2, 0x06, 0xbb, 0x6f, 0xe1, 0x87, 0x1f, 0x17, 0x8c, 0x56, 0xd8, 0xcd, 0xb6, 0x5d, 0x81, 0xb7,
+	0x21, 0xb8, 0x06, 0xe3, 0xce, 0xcd, 0xea, 0x68, 0xb9, 0xe7, 0xba, 0x79, 0x74, 0xe9, 0xe2, 0xa0,
+	0xad, 0x88, 0xf1, 0x8e, 0x7b, 0xe9, 0x6f, 0x24, 0xf3, 0x42, 0xa8, 0xac, 0x06, 0x4a, 0x50, 0xfc,
+	0x2e, 0x38, 0xbc, 0x8f, 0x16, 0x74, 0x86, 0x2a, 0xae, 0xe2, 0x38, 0xd3, 0xac, 0x36, 0xdd, 0x74,
+	0x6e, 0x97, 0x54, 0x49, 0x5d, 0x6d, 0x37, 0x74, 0xc1, 0x37, 0x07, 0x04, 0xbf, 0x09, 0x29, 0x5a,
+	0x84, 0xa3, 0xe3, 0xad, 0x23, 0xf4, 0x40, 0x1a, 0xf1, 0x9a, 0x79, 0xed, 0xc8, 0xe6, 0x51, 0x81,
+	0xb3, 0x0f, 0xc1, 0xa5, 0xf3, 0x03, 0x2f, 0x46, 0xe9, 0x68, 0x99, 0xcf, 0xc1, 0x3f, 0x96, 0x00,
+	0xb1, 0x1c, 0x7c, 0xb3, 0xdd, 0x52, 0xeb, 0xfb, 0x2f, 0x48, 0xeb, 0xd1, 0xeb, 0x10, 0x97, 0x89,
+	0xa2, 0x5b, 0x17, 0x58, 0xd6, 0x10, 0x01, 0xc6, 0x7f, 0x94, 0x00, 0x72, 0xdd, 0x46, 0xd3, 0x28,
+	0x1e, 0x92, 0xb6, 0xd1, 0xa7, 0xea, 0x33, 0x56, 0xcd, 0x8b, 0x0b, 0x45, 0xb4, 0xa8, 0x48, 0x72,
+	0x75, 0x43, 0xd5, 0x4c, 0x91, 0xb0, 0x46, 0x2f, 0x0b, 0xa3, 0xfe, 0x62, 0x8e, 0xf5, 0x88, 0x79,
+	0x06, 0xe2, 0x05, 0x62, 0x28, 0xcd, 0x96, 0xd0, 0x63, 0xd1, 0x72, 0xdb, 0xc0, 0x48, 0x8f, 0x0d,
+	0xe0, 0x32, 0x8c, 0xda, 0xf4, 0xb3, 0xb4, 0x94, 0x7f, 0x05, 0x90, 0x8a, 0x3d, 0x4f, 0x16, 0x93,
+	0xf0, 0x32, 0xcc, 0x14, 0x9f, 0xd0, 0xb8, 0x18, 0xfc, 0x4a, 0x25, 0x05, 0x91, 0x0f, 0xc8, 0x91,
+	0xe0, 0x0f, 0xfd, 0xc4, 0x18, 0xc6, 0x72, 0x5a, 0x7d, 0xaf, 0x79, 0x48, 0x96, 0xf7, 0xba, 0xed,
+	0x7d, 0xcf, 0x0a, 0xe5, 0x23, 0x98, 0x29, 0x1d, 0x78, 0x2e, 0x34, 0xd8, 0x56, 0xfa, 0x56, 0xb4,
+	0x56, 0x88, 0x38, 0x56, 0xf8, 0x02, 0x4e, 0xb9, 0x8c, 0xf3, 0x05, 0x69, 0xd8, 0x4b, 0x76, 0x26,
+	0xed, 0xf6, 0xe9, 0x87, 0xcc, 0xe1, 0x56, 0xba, 0x46, 0xa7, 0x6b, 0xbc, 0xa8, 0xc5, 0x3d, 0xca,
+	0xb4, 0xf8, 0x12, 0x4c, 0x39, 0xd6, 0x15, 0x9e, 0x7e, 0x06, 0xe2, 0x2a, 0x83, 0x08, 0x21, 0x88,
+	0xd6, 0x42, 0x1b, 0xe2, 0x2c, 0x41, 0xd4, 0xd1, 0x24, 0x8c, 0xae, 0x57, 0x6a, 0x0f, 0x73, 0xe5,
+	0x72, 0xe5, 0x6e, 0xb1, 0x90, 0x0a, 0xa1, 0x71, 0x48, 0x52, 0xc0, 0x4a, 0x65, 0x73, 0xbd, 0x90,
+	0x92, 0x10, 0x40, 0xbc, 0x5c, 0x59, 0xfe, 0xa0, 0x58, 0x48, 0x85, 0x11, 0x82, 0x89, 0xd2, 0x7a,
+	0xad, 0x28, 0xaf, 0xe7, 0xca, 0x0f, 0x8b, 0xb2, 0x5c, 0x91, 0x53, 0x11, 0x34, 0x05, 0xe3, 0xa5,
+	0xf5, 0x3b, 0xb9, 0x72, 0xa9, 0xf0, 0xf0, 0x4e, 0xae, 0xbc, 0x59, 0x4c, 0x45, 0x29, 0xe8, 0x76,
+	0xa9, 0x5a, 0x2d, 0xad, 0xaf, 0x0a, 0x50, 0x6c, 0x01, 0x9b, 0x89, 0x36, 0x1a, 0x83, 0x44, 0x69,
+	0x3d, 0xb7, 0x5c, 0x2b, 0xdd, 0x29, 0xa6, 0x42, 0x14, 0xbb, 0xf8, 0x96, 0x16, 0x1e, 0x40, 0xc2,
+	0xcc, 0xb1, 0xd1, 0x28, 0x8c, 0x6c, 0x14, 0xd7, 0x0b, 0xa5, 0xf5, 0xd5, 0x54, 0x88, 0x36, 0xe4,
+	0xcd, 0xf5, 0x75, 0xda, 0x60, 0xf4, 0xac, 0xe4, 0x4a, 0x65, 0x46, 0xcf, 0x28, 0x8c, 0xe4, 0xf2,
+	0x15, 0xb9, 0x56, 0x2c, 0xa4, 0x22, 0x28, 0x01, 0xd1, 0x42, 0x65, 0x9d, 0xae, 0x9f, 0x84, 0x18,
+	0xa7, 0x2e, 0x46, 0x47, 0x7f, 0xb8, 0x59, 0xdc, 0x2c, 0x16, 0x52, 0xf1, 0x05, 0xab, 0x8e, 0x4d,
+	0xa1, 0xcb, 0x72, 0x31, 0x57, 0x13, 0x14, 0x6c, 0x6e, 0x14, 0xe8, 0x37, 0xc3, 0x5d, 0x28, 0x96,
+	0x8b, 0xb5, 0x22, 0xc7, 0x2d, 0x17, 0x37, 0xca, 0xb9, 0xe5, 0x62, 0x2a, 0xb2, 0xf0, 0x2e, 0x24,
+	0xad, 0x83, 0x03, 0x45, 0x9f, 0xdb, 0xd8, 0x28, 0xdf, 0xe3, 0x94, 0x15, 0x8a, 0xd5, 0x9a, 0x5c,
+	0xb9, 0x97, 0x92, 0xf8, 0x8c, 0x15, 0xb9, 0x58, 0x7d, 0x3f, 0x15, 0xa6, 0xa8, 0x4a, 0xb7, 0x37,
+	0x2a, 0x72, 0x2d, 0x15, 0x59, 0xb8, 0x06, 0x60, 0x3f, 0x28, 0xa0, 0xc3, 0x96, 0xdf, 0xcf, 0xad,
+	0xaf, 0x32, 0x66, 0x53, 0x5c, 0x85, 0x42, 0xb1, 0x60, 0x4e, 0xbf, 0x5d, 0xb9, 0x43, 0x77, 0xb6,
+	0x70, 0x13, 0xc0, 0x0e, 0x96, 0x68, 0x02, 0x20, 0x5f, 0x5c, 0xa9, 0xc8, 0xc5, 0x87, 0x6b, 0x95,
+	0x3c, 0x17, 0x51, 0x6e, 0xa5, 0x56, 0x94, 0x59, 0x53, 0xa2, 0x2c, 0x95, 0x8b, 0xd5, 0x5a, 0x45,
+	0xa6, 0x53, 0x97, 0xfe, 0x75, 0x1e, 0xa0, 0x66, 0xd9, 0x3c, 0x3a, 0x82, 0x71, 0xd7, 0x55, 0x29,
+	0xca, 0xfa, 0x45, 0x35, 0x8f, 0x4b, 0xd5, 0xcc, 0xab, 0x7e, 0xc7, 0xa9, 0x7d, 0x9c, 0xfe, 0xe1,
+	0x5f, 0xff, 0xf1, 0x55, 0x18, 0xe1, 0xf1, 0xec, 0xe1, 0xb5, 0xec, 0x27, 0xe6, 0xe4, 0xb7, 0xa5,
+	0x05, 0xf4, 0x03, 0x09, 0xc6, 0x9c, 0xf7, 0xaa, 0x68, 0xd1, 0x07, 0x93, 0xc7, 0xd3, 0xb8, 0x4c,
+	0xa0, 0x87, 0x48, 0x38, 0xc3, 0x08, 0x98, 0x46, 0xc8, 0x45, 0x40, 0xf6, 0x7b, 0xa5, 0xc6, 0xe7,
+	0xe8, 0xfb, 0x70, 0xca, 0xe3, 0x61, 0x1b, 0xba, 0xe1, 0x83, 0xf8, 0xf8, 0x87, 0x70, 0x99, 0x6c,
+	0x10, 0x7a, 0x1c, 0xd7, 0x9d, 0x38, 0x84, 0xbe, 0x80, 0x34, 0x8d, 0xb8, 0xce, 0x5e, 0x2b, 0xe3,
+	0x18, 0x96, 0x1d, 0x57, 0x87, 0x5c, 0x5e, 0xc7, 0x21, 0xf4, 0x04, 0xa6, 0xfa, 0x1e, 0xca, 0xa1,
+	0xeb, 0x3e, 0x88, 0x8e, 0x7b, 0x56, 0x97, 0xb9, 0x1c, 0x74, 0x75, 0x8a, 0x01, 0x87, 0xd0, 0x97,
+	0x12, 0x4c, 0x8b, 0x7c, 0xcb, 0xbd, 0xfa, 0xff, 0xf9, 0x5f, 0xf2, 0x1c, 0xf7, 0xee, 0xed, 0x79,
+	0xb8, 0xff, 0xb5, 0xe4, 0x7e, 0x73, 0x69, 0x16, 0x71, 0x6e, 0x04, 0xe4, 0xbc, 0xfb, 0x25, 0x40,
+	0x06, 0x0f, 0x4c, 0x75, 0x74, 0x8c, 0x99, 0x36, 0x9e, 0x41, 0x99, 0x7e, 0x6d, 0xcc, 0x8a, 0x67,
+	0x6e, 0xe8, 0xd7, 0x12, 0x80, 0x7d, 0x05, 0x82, 0x2e, 0x0f, 0xb0, 0x48, 0xd7, 0xc9, 0x3d, 0x73,
+	0x25, 0xe0, 0x68, 0x1e, 0x01, 0xf0, 0x15, 0x46, 0xcf, 0x45, 0x8c, 0x7b, 0xe8, 0x71, 0xc4, 0x1c,
+	0x93, 0x30, 0x6a, 0xb3, 0x3f, 0x91, 0x20, 0xb9, 0x6a, 0xde, 0xb5, 0xa0, 0xb9, 0x81, 0x1b, 0x36,
+	0xa9, 0x1a, 0xfc, 0xca, 0x0f, 0x67, 0x19, 0x25, 0xf3, 0xe8, 0xe2, 0x60, 0x4a, 0xb8, 0xf1, 0x7e,
+	0x23, 0xc1, 0xa8, 0xe3, 0x02, 0x06, 0xf9, 0xed, 0xbc, 0xff, 0xa2, 0xc6, 0xd7, 0x7b, 0x58, 0xcf,
+	0x36, 0xf0, 0xff, 0x33, 0xaa, 0x96, 0xf0, 0x95, 0x80, 0x54, 0x65, 0x15, 0xba, 0x12, 0x65, 0xd5,
+	0x6f, 0x25, 0x18, 0x77, 0x15, 0xf1, 0x7d, 0x5d, 0xab, 0x57, 0xb9, 0x3f, 0x20, 0x89, 0x6f, 0x31,
+	0x12, 0xaf, 0x2d, 0x64, 0x83, 0x92, 0xd8, 0xe0, 0x6b, 0xa1, 0x8f, 0x60, 0xc2, 0x7d, 0xf1, 0x81,
+	0xfc, 0x5c, 0x88, 0xe7, 0x1d, 0x49, 0x40, 0x12, 0x43, 0x48, 0x07, 0xd4, 0xff, 0x06, 0x10, 0xbd,
+	0x39, 0xc0, 0xd3, 0x78, 0x3e, 0x19, 0xcc, 0x0c, 0x3e, 0x52, 0x08, 0x1f, 0xb3, 0x07, 0xe3, 0xae,
+	0x07, 0x71, 0xbe, 0x12, 0xf0, 0x7a, 0x3a, 0x97, 0x09, 0xf4, 0x8a, 0x0a, 0x87, 0x50, 0x1d, 0x26,
+	0xa8, 0x1f, 0xb7, 0x40, 0xfa, 0x10, 0xb6, 0x71, 0x3e, 0xc8, 0x1a, 0x94, 0x87, 0x0f, 0x61, 0xcc,
+	0x79, 0x0f, 0xe2, 0x1b, 0x20, 0x3c, 0x2e, 0x4c, 0x06, 0x45, 0xea, 0x10, 0x22, 0x30, 0xd9, 0x73,
+	0xef, 0x81, 0xae, 0x0d, 0x5c, 0x63, 0xd8, 0x84, 0x20, 0x84, 0xee, 0x41, 0xd2, 0xba, 0xe4, 0x40,
+	0x97, 0x06, 0xf8, 0x2b, 0xe7, 0x55, 0xc3, 0x60, 0xd4, 0x77, 0x21, 0xc1, 0xcb, 0x19, 0xf5, 0x7d,
+	0x74, 0x71, 0x50, 0x75, 0xd4, 0xc4, 0x3a, 0x3b, 0x68, 0x20, 0x0e, 0xa1, 0x2d, 0x00, 0xe6, 0x43,
+	0x86, 0x44, 0x3d, 0xb0, 0x42, 0x2b, 0x77, 0xa9, 0xfa, 0x3c, 0xa0, 0x92, 0x65, 0x46, 0xf9, 0x62,
+	0xf0, 0x3f, 0x82, 0x51, 0x93, 0x2d, 0xb4, 0x6e, 0xbe, 0x10, 0x60, 0xd6, 0x90, 0x2b, 0xec, 0xc1,
+	0xb8, 0xab, 0x90, 0xec, 0x6b, 0x6a, 0x5e, 0x25, 0xe7, 0xc0, 0x9e, 0xe4, 0x23, 0x98, 0x70, 0xd7,
+	0x68, 0x7d, 0xbd, 0x96, 0x67, 0x39, 0x37, 0xf0, 0x5a, 0x32, 0x24, 0x72, 0x3b, 0xaa, 0x46, 0x9f,
+	0x25, 0xa1, 0xf3, 0xfe, 0x73, 0x02, 0xab, 0xe8, 0x87, 0x10, 0x5f, 0x25, 0xc3, 0x60, 0x1c, 0xf0,
+	0x3e, 0x88, 0x19, 0x54, 0xc2, 0x7c, 0x3c, 0xe5, 0x2b, 0xdb, 0x9e, 0x17, 0x56, 0x99, 0xd7, 0xfd,
+	0x31, 0x53, 0x0e, 0x6c, 0xd3, 0x3a, 0x98, 0x46, 0x94, 0x03, 0xfe, 0xac, 0x48, 0x0f, 0x4a, 0xf4,
+	0x59, 0xff, 0x61, 0x65, 0x75, 0x17, 0x87, 0xae, 0x4a, 0xa8, 0x0a, 0x23, 0xab, 0xc4, 0x60, 0x2f,
+	0x41, 0x02, 0x22, 0xf6, 0xa3, 0x99, 0xe2, 0xc1, 0x21, 0x74, 0x1f, 0xc0, 0xae, 0x55, 0xfb, 0xa7,
+	0x4f, 0xbd, 0x25, 0xed, 0x60, 0xce, 0xcb, 0xac, 0xf5, 0xfa, 0x3b, 0xaf, 0x9e, 0x8a, 0xf0, 0x60,
+	0xd4, 0x0d, 0x48, 0x55, 0x89, 0xe1, 0xaa, 0x5c, 0xfb, 0xe7, 0x0c, 0x1e, 0x35, 0xee, 0x20, 0x4e,
+	0x3e, 0x61, 0x56, 0x7c, 0x7d, 0x95, 0xa5, 0xa7, 0xf2, 0x9c, 0xb9, 0x14, 0x68, 0xac, 0x48, 0x2b,
+	0x43, 0x68, 0x1f, 0xa6, 0xa8, 0xba, 0xb9, 0x8b, 0xa8, 0xc3, 0xac, 0x37, 0x17, 0xb0, 0xbc, 0x4a,
+	0xb5, 0xb4, 0x63, 0x17, 0xca, 0x05, 0xd4, 0x37, 0x38, 0x7a, 0x14, 0x80, 0x86, 0xdd, 0x5e, 0xd3,
+	0xaa, 0x31, 0x73, 0x4e, 0x0e, 0xbb, 0x5c, 0xd0, 0xe2, 0x31, 0x0e, 0xa1, 0x1d, 0x48, 0x5a, 0x55,
+	0x59, 0x5f, 0x8d, 0xeb, 0xad, 0xdd, 0x66, 0x2e, 0x04, 0xaa, 0xbd, 0x72, 0x33, 0x1f, 0x75, 0x94,
+	0x5d, 0x7d, 0x53, 0xe9, 0xfe, 0xf2, 0xec, 0x60, 0x9d, 0xdb, 0xe7, 0xaf, 0x3b, 0x9d, 0x15, 0xc9,
+	0x61, 0x4f, 0xb7, 0x17, 0x02, 0x55, 0x2c, 0x75, 0x16, 0x8a, 0x92, 0x56, 0xa5, 0x0b, 0x0d, 0x10,
+	0xab, 0xab, 0x0e, 0x97, 0xb9, 0x1c, 0x6c, 0xb0, 0xa5, 0x04, 0x1f, 0x03, 0xa2, 0xaa, 0x41, 0xda,
+	0xf4, 0x45, 0xed, 0x21, 0xf9, 0x36, 0x96, 0xdc, 0x66, 0xe5, 0x43, 0xf7, 0x9f, 0xb4, 0xfc, 0xd9,
+	0xef, 0x6b, 0x48, 0x2e, 0x44, 0x6c, 0x47, 0x93, 0x3d, 0x85, 0x5e, 0xdf, 0x0c, 0xd0, 0xbb, 0x28,
+	0xec, 0xab, 0xdc, 0xce, 0x12, 0x30, 0x0b, 0x01, 0xbb, 0x30, 0x59, 0x3a, 0x08, 0xbe, 0xa4, 0x77,
+	0x79, 0x78, 0xa0, 0x0a, 0xce, 0x49, 0xf9, 0x0b, 0xdf, 0x3d, 0xe7, 0xf8, 0x97, 0xa6, 0x18, 0xee,
+	0xf8, 0x0f, 0x68, 0x96, 0x0f, 0xdf, 0x89, 0xb3, 0xff, 0x63, 0x5e, 0xff, 0xf7, 0x00, 0x80, 0xd5,
+	0x3f, 0x77, 0x25, 0x3a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TessellateClient is the client API for Tessellate service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TessellateClient interface {
+	SaveWorkspace(ctx context.Context, in *SaveWorkspaceRequest, opts ...grpc.CallOption) (*Ok, error)
+	GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*Workspace, error)
+	UpdateWorkspaceVars(ctx context.Context, in *UpdateWorkspaceVarsRequest, opts ...grpc.CallOption) (*WorkspaceVarsVersion, error)
+	ListWorkspaceVarVersions(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*WorkspaceVarsVersions, error)
+	DiffWorkspaceVars(ctx context.Context, in *DiffWorkspaceVarsRequest, opts ...grpc.CallOption) (*WorkspaceVarsDiff, error)
+	RestoreWorkspaceVars(ctx context.Context, in *RestoreWorkspaceVarsRequest, opts ...grpc.CallOption) (*WorkspaceVarsVersion, error)
+	GetWorkspaceLayouts(ctx context.Context, in *GetWorkspaceLayoutsRequest, opts ...grpc.CallOption) (*Layouts, error)
 	SaveLayout(ctx context.Context, in *SaveLayoutRequest, opts ...grpc.CallOption) (*SaveLayoutResponse, error)
 	GetLayout(ctx context.Context, in *LayoutRequest, opts ...grpc.CallOption) (*Layout, error)
 	ApplyLayout(ctx context.Context, in *ApplyLayoutRequest, opts ...grpc.CallOption) (*JobStatus, error)
 	DestroyLayout(ctx context.Context, in *DestroyLayoutRequest, opts ...grpc.CallOption) (*JobStatus, error)
+	RollbackLayout(ctx context.Context, in *RollbackLayoutRequest, opts ...grpc.CallOption) (*JobStatus, error)
+	DiffLayoutVersions(ctx context.Context, in *DiffLayoutVersionsRequest, opts ...grpc.CallOption) (*LayoutDiff, error)
+	PromoteLayout(ctx context.Context, in *PromoteLayoutRequest, opts ...grpc.CallOption) (*Promotion, error)
+	ListPromotions(ctx context.Context, in *LayoutRequest, opts ...grpc.CallOption) (*Promotions, error)
+	DeleteLayout(ctx context.Context, in *DeleteLayoutRequest, opts ...grpc.CallOption) (*Ok, error)
+	DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*Ok, error)
+	SaveStack(ctx context.Context, in *SaveStackRequest, opts ...grpc.CallOption) (*Ok, error)
+	GetStack(ctx context.Context, in *StackRequest, opts ...grpc.CallOption) (*Stack, error)
+	ApplyStack(ctx context.Context, in *StackRequest, opts ...grpc.CallOption) (*StackRun, error)
+	DestroyStack(ctx context.Context, in *StackRequest, opts ...grpc.CallOption) (*StackRun, error)
+	GetStackRun(ctx context.Context, in *StackRunRequest, opts ...grpc.CallOption) (*StackRun, error)
+	RefreshLayout(ctx context.Context, in *RefreshLayoutRequest, opts ...grpc.CallOption) (*JobStatus, error)
+	ImportResource(ctx context.Context, in *ImportResourceRequest, opts ...grpc.CallOption) (*JobStatus, error)
 	AbortJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Ok, error)
+	GetJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*Jobs, error)
+	StreamJobLogs(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (Tessellate_StreamJobLogsClient, error)
+	GetPlan(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Plan, error)
 	StartWatch(ctx context.Context, in *StartWatchRequest, opts ...grpc.CallOption) (*Ok, error)
 	StopWatch(ctx context.Context, in *StopWatchRequest, opts ...grpc.CallOption) (*Ok, error)
+	SetDriftSchedule(ctx context.Context, in *DriftScheduleRequest, opts ...grpc.CallOption) (*Ok, error)
 	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
+	ListStateVersions(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*StateVersions, error)
+	GetStateVersion(ctx context.Context, in *StateVersionRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
+	// Make an older version of the state the current one. Allowed to admins only.
+	RestoreState(ctx context.Context, in *StateVersionRequest, opts ...grpc.CallOption) (*StateVersion, error)
+	// Locks held on Layouts, and releasing them by force. Allowed to admins only.
+	ListLocks(ctx context.Context, in *ListLocksRequest, opts ...grpc.CallOption) (*LayoutLocks, error)
+	ForceUnlock(ctx context.Context, in *ForceUnlockRequest, opts ...grpc.CallOption) (*Ok, error)
+	ListAuditEvents(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*AuditEvents, error)
 	GetOutput(ctx context.Context, in *GetOutputRequest, opts ...grpc.CallOption) (*GetOutputResponse, error)
+	// GetOutput, along with the values of sensitive outputs. Allowed to admins only.
+	// Callers can be limited further with a 2FA rule for the method.
+	GetSensitiveOutput(ctx context.Context, in *GetOutputRequest, opts ...grpc.CallOption) (*GetOutputResponse, error)
 	GetAllWorkspaces(ctx context.Context, in *Ok, opts ...grpc.CallOption) (*AllWorkspaces, error)
+	// A Workspace as a tar.gz, to be backed up or moved to another installation.
+	// Allowed to admins only.
+	ExportWorkspace(ctx context.Context, in *ExportWorkspaceRequest, opts ...grpc.CallOption) (Tessellate_ExportWorkspaceClient, error)
+	ImportWorkspace(ctx context.Context, opts ...grpc.CallOption) (Tessellate_ImportWorkspaceClient, error)
+}
+
+type tessellateClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTessellateClient(cc *grpc.ClientConn) TessellateClient {
+	return &tessellateClient{cc}
+}
+
+func (c *tessellateClient) SaveWorkspace(ctx context.Context, in *SaveWorkspaceRequest, opts ...grpc.CallOption) (*Ok, error) {
+	out := new(Ok)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/SaveWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*Workspace, error) {
+	out := new(Workspace)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) UpdateWorkspaceVars(ctx context.Context, in *UpdateWorkspaceVarsRequest, opts ...grpc.CallOption) (*WorkspaceVarsVersion, error) {
+	out := new(WorkspaceVarsVersion)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/UpdateWorkspaceVars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ListWorkspaceVarVersions(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*WorkspaceVarsVersions, error) {
+	out := new(WorkspaceVarsVersions)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ListWorkspaceVarVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) DiffWorkspaceVars(ctx context.Context, in *DiffWorkspaceVarsRequest, opts ...grpc.CallOption) (*WorkspaceVarsDiff, error) {
+	out := new(WorkspaceVarsDiff)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/DiffWorkspaceVars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) RestoreWorkspaceVars(ctx context.Context, in *RestoreWorkspaceVarsRequest, opts ...grpc.CallOption) (*WorkspaceVarsVersion, error) {
+	out := new(WorkspaceVarsVersion)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/RestoreWorkspaceVars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetWorkspaceLayouts(ctx context.Context, in *GetWorkspaceLayoutsRequest, opts ...grpc.CallOption) (*Layouts, error) {
+	out := new(Layouts)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetWorkspaceLayouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) SaveLayout(ctx context.Context, in *SaveLayoutRequest, opts ...grpc.CallOption) (*SaveLayoutResponse, error) {
+	out := new(SaveLayoutResponse)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/SaveLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetLayout(ctx context.Context, in *LayoutRequest, opts ...grpc.CallOption) (*Layout, error) {
+	out := new(Layout)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ApplyLayout(ctx context.Context, in *ApplyLayoutRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ApplyLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) DestroyLayout(ctx context.Context, in *DestroyLayoutRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/DestroyLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) RollbackLayout(ctx context.Context, in *RollbackLayoutRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/RollbackLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) DiffLayoutVersions(ctx context.Context, in *DiffLayoutVersionsRequest, opts ...grpc.CallOption) (*LayoutDiff, error) {
+	out := new(LayoutDiff)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/DiffLayoutVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) PromoteLayout(ctx context.Context, in *PromoteLayoutRequest, opts ...grpc.CallOption) (*Promotion, error) {
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/PromoteLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ListPromotions(ctx context.Context, in *LayoutRequest, opts ...grpc.CallOption) (*Promotions, error) {
+	out := new(Promotions)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ListPromotions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) DeleteLayout(ctx context.Context, in *DeleteLayoutRequest, opts ...grpc.CallOption) (*Ok, error) {
+	out := new(Ok)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/DeleteLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*Ok, error) {
+	out := new(Ok)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/DeleteWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) SaveStack(ctx context.Context, in *SaveStackRequest, opts ...grpc.CallOption) (*Ok, error) {
+	out := new(Ok)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/SaveStack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetStack(ctx context.Context, in *StackRequest, opts ...grpc.CallOption) (*Stack, error) {
+	out := new(Stack)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetStack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ApplyStack(ctx context.Context, in *StackRequest, opts ...grpc.CallOption) (*StackRun, error) {
+	out := new(StackRun)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ApplyStack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) DestroyStack(ctx context.Context, in *StackRequest, opts ...grpc.CallOption) (*StackRun, error) {
+	out := new(StackRun)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/DestroyStack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetStackRun(ctx context.Context, in *StackRunRequest, opts ...grpc.CallOption) (*StackRun, error) {
+	out := new(StackRun)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetStackRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) RefreshLayout(ctx context.Context, in *RefreshLayoutRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/RefreshLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ImportResource(ctx context.Context, in *ImportResourceRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ImportResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) AbortJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Ok, error) {
+	out := new(Ok)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/AbortJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*Jobs, error) {
+	out := new(Jobs)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) StreamJobLogs(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (Tessellate_StreamJobLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tessellate_serviceDesc.Streams[0], "/tsocial.tessellate.server.Tessellate/StreamJobLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tessellateStreamJobLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tessellate_StreamJobLogsClient interface {
+	Recv() (*JobLog, error)
+	grpc.ClientStream
+}
+
+type tessellateStreamJobLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tessellateStreamJobLogsClient) Recv() (*JobLog, error) {
+	m := new(JobLog)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tessellateClient) GetPlan(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Plan, error) {
+	out := new(Plan)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) StartWatch(ctx context.Context, in *StartWatchRequest, opts ...grpc.CallOption) (*Ok, error) {
+	out := new(Ok)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/StartWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) StopWatch(ctx context.Context, in *StopWatchRequest, opts ...grpc.CallOption) (*Ok, error) {
+	out := new(Ok)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/StopWatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) SetDriftSchedule(ctx context.Context, in *DriftScheduleRequest, opts ...grpc.CallOption) (*Ok, error) {
+	out := new(Ok)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/SetDriftSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error) {
+	out := new(GetStateResponse)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ListStateVersions(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*StateVersions, error) {
+	out := new(StateVersions)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ListStateVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetStateVersion(ctx context.Context, in *StateVersionRequest, opts ...grpc.CallOption) (*GetStateResponse, error) {
+	out := new(GetStateResponse)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetStateVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) RestoreState(ctx context.Context, in *StateVersionRequest, opts ...grpc.CallOption) (*StateVersion, error) {
+	out := new(StateVersion)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/RestoreState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ListLocks(ctx context.Context, in *ListLocksRequest, opts ...grpc.CallOption) (*LayoutLocks, error) {
+	out := new(LayoutLocks)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ListLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ForceUnlock(ctx context.Context, in *ForceUnlockRequest, opts ...grpc.CallOption) (*Ok, error) {
+	out := new(Ok)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ForceUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ListAuditEvents(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*AuditEvents, error) {
+	out := new(AuditEvents)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetOutput(ctx context.Context, in *GetOutputRequest, opts ...grpc.CallOption) (*GetOutputResponse, error) {
+	out := new(GetOutputResponse)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetSensitiveOutput(ctx context.Context, in *GetOutputRequest, opts ...grpc.CallOption) (*GetOutputResponse, error) {
+	out := new(GetOutputResponse)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetSensitiveOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) GetAllWorkspaces(ctx context.Context, in *Ok, opts ...grpc.CallOption) (*AllWorkspaces, error) {
+	out := new(AllWorkspaces)
+	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetAllWorkspaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tessellateClient) ExportWorkspace(ctx context.Context, in *ExportWorkspaceRequest, opts ...grpc.CallOption) (Tessellate_ExportWorkspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tessellate_serviceDesc.Streams[1], "/tsocial.tessellate.server.Tessellate/ExportWorkspace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tessellateExportWorkspaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tessellate_ExportWorkspaceClient interface {
+	Recv() (*ArchiveChunk, error)
+	grpc.ClientStream
+}
+
+type tessellateExportWorkspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *tessellateExportWorkspaceClient) Recv() (*ArchiveChunk, error) {
+	m := new(ArchiveChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tessellateClient) ImportWorkspace(ctx context.Context, opts ...grpc.CallOption) (Tessellate_ImportWorkspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tessellate_serviceDesc.Streams[2], "/tsocial.tessellate.server.Tessellate/ImportWorkspace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tessellateImportWorkspaceClient{stream}
+	return x, nil
+}
+
+type Tessellate_ImportWorkspaceClient interface {
+	Send(*ImportWorkspaceRequest) error
+	CloseAndRecv() (*Ok, error)
+	grpc.ClientStream
+}
+
+type tessellateImportWorkspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *tessellateImportWorkspaceClient) Send(m *ImportWorkspaceRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *tessellateImportWorkspaceClient) CloseAndRecv() (*Ok, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Ok)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TessellateServer is the server API for Tessellate service.
+type TessellateServer interface {
+	SaveWorkspace(context.Context, *SaveWorkspaceRequest) (*Ok, error)
+	GetWorkspace(context.Context, *GetWorkspaceRequest) (*Workspace, error)
+	UpdateWorkspaceVars(context.Context, *UpdateWorkspaceVarsRequest) (*WorkspaceVarsVersion, error)
+	ListWorkspaceVarVersions(context.Context, *GetWorkspaceRequest) (*WorkspaceVarsVersions, error)
+	DiffWorkspaceVars(context.Context, *DiffWorkspaceVarsRequest) (*WorkspaceVarsDiff, error)
+	RestoreWorkspaceVars(context.Context, *RestoreWorkspaceVarsRequest) (*WorkspaceVarsVersion, error)
+	GetWorkspaceLayouts(context.Context, *GetWorkspaceLayoutsRequest) (*Layouts, error)
+	SaveLayout(context.Context, *SaveLayoutRequest) (*SaveLayoutResponse, error)
+	GetLayout(context.Context, *LayoutRequest) (*Layout, error)
+	ApplyLayout(context.Context, *ApplyLayoutRequest) (*JobStatus, error)
+	DestroyLayout(context.Context, *DestroyLayoutRequest) (*JobStatus, error)
+	RollbackLayout(context.Context, *RollbackLayoutRequest) (*JobStatus, error)
+	DiffLayoutVersions(context.Context, *DiffLayoutVersionsRequest) (*LayoutDiff, error)
+	PromoteLayout(context.Context, *PromoteLayoutRequest) (*Promotion, error)
+	ListPromotions(context.Context, *LayoutRequest) (*Promotions, error)
+	DeleteLayout(context.Context, *DeleteLayoutRequest) (*Ok, error)
+	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*Ok, error)
+	SaveStack(context.Context, *SaveStackRequest) (*Ok, error)
+	GetStack(context.Context, *StackRequest) (*Stack, error)
+	ApplyStack(context.Context, *StackRequest) (*StackRun, error)
+	DestroyStack(context.Context, *StackRequest) (*StackRun, error)
+	GetStackRun(context.Context, *StackRunRequest) (*StackRun, error)
+	RefreshLayout(context.Context, *RefreshLayoutRequest) (*JobStatus, error)
+	ImportResource(context.Context, *ImportResourceRequest) (*JobStatus, error)
+	AbortJob(context.Context, *JobRequest) (*Ok, error)
+	GetJob(context.Context, *JobRequest) (*Job, error)
+	ListJobs(context.Context, *ListJobsRequest) (*Jobs, error)
+	StreamJobLogs(*JobRequest, Tessellate_StreamJobLogsServer) error
+	GetPlan(context.Context, *JobRequest) (*Plan, error)
+	StartWatch(context.Context, *StartWatchRequest) (*Ok, error)
+	StopWatch(context.Context, *StopWatchRequest) (*Ok, error)
+	SetDriftSchedule(context.Context, *DriftScheduleRequest) (*Ok, error)
+	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
+	ListStateVersions(context.Context, *GetStateRequest) (*StateVersions, error)
+	GetStateVersion(context.Context, *StateVersionRequest) (*GetStateResponse, error)
+	// Make an older version of the state the current one. Allowed to admins only.
+	RestoreState(context.Context, *StateVersionRequest) (*StateVersion, error)
+	// Locks held on Layouts, and releasing them by force. Allowed to admins only.
+	ListLocks(context.Context, *ListLocksRequest) (*LayoutLocks, error)
+	ForceUnlock(context.Context, *ForceUnlockRequest) (*Ok, error)
+	ListAuditEvents(context.Context, *GetWorkspaceRequest) (*AuditEvents, error)
+	GetOutput(context.Context, *GetOutputRequest) (*GetOutputResponse, error)
+	// GetOutput, along with the values of sensitive outputs. Allowed to admins only.
+	// Callers can be limited further with a 2FA rule for the method.
+	GetSensitiveOutput(context.Context, *GetOutputRequest) (*GetOutputResponse, error)
+	GetAllWorkspaces(context.Context, *Ok) (*AllWorkspaces, error)
+	// A Workspace as a tar.gz, to be backed up or moved to another installation.
+	// Allowed to admins only.
+	ExportWorkspace(*ExportWorkspaceRequest, Tessellate_ExportWorkspaceServer) error
+	ImportWorkspace(Tessellate_ImportWorkspaceServer) error
+}
+
+func RegisterTessellateServer(s *grpc.Server, srv TessellateServer) {
+	s.RegisterService(&_Tessellate_serviceDesc, srv)
+}
+
+func _Tessellate_SaveWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).SaveWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/SaveWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).SaveWorkspace(ctx, req.(*SaveWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_GetWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).GetWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/GetWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).GetWorkspace(ctx, req.(*GetWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_UpdateWorkspaceVars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceVarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).UpdateWorkspaceVars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/UpdateWorkspaceVars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).UpdateWorkspaceVars(ctx, req.(*UpdateWorkspaceVarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_ListWorkspaceVarVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).ListWorkspaceVarVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/ListWorkspaceVarVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).ListWorkspaceVarVersions(ctx, req.(*GetWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_DiffWorkspaceVars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffWorkspaceVarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).DiffWorkspaceVars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/DiffWorkspaceVars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).DiffWorkspaceVars(ctx, req.(*DiffWorkspaceVarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_RestoreWorkspaceVars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWorkspaceVarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).RestoreWorkspaceVars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/RestoreWorkspaceVars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).RestoreWorkspaceVars(ctx, req.(*RestoreWorkspaceVarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_GetWorkspaceLayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceLayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).GetWorkspaceLayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/GetWorkspaceLayouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).GetWorkspaceLayouts(ctx, req.(*GetWorkspaceLayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type tessellateClient struct {
-	cc *grpc.ClientConn
+func _Tessellate_SaveLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).SaveLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/SaveLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).SaveLayout(ctx, req.(*SaveLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewTessellateClient(cc *grpc.ClientConn) TessellateClient {
-	return &tessellateClient{cc}
+func _Tessellate_GetLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).GetLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/GetLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).GetLayout(ctx, req.(*LayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) SaveWorkspace(ctx context.Context, in *SaveWorkspaceRequest, opts ...grpc.CallOption) (*Ok, error) {
-	out := new(Ok)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/SaveWorkspace", in, out, opts...)
-	if err != nil {
+func _Tessellate_ApplyLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyLayoutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).ApplyLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/ApplyLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).ApplyLayout(ctx, req.(*ApplyLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*Workspace, error) {
-	out := new(Workspace)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetWorkspace", in, out, opts...)
-	if err != nil {
+func _Tessellate_DestroyLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyLayoutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).DestroyLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/DestroyLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).DestroyLayout(ctx, req.(*DestroyLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) GetWorkspaceLayouts(ctx context.Context, in *GetWorkspaceLayoutsRequest, opts ...grpc.CallOption) (*Layouts, error) {
-	out := new(Layouts)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetWorkspaceLayouts", in, out, opts...)
-	if err != nil {
+func _Tessellate_RollbackLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackLayoutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).RollbackLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/RollbackLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).RollbackLayout(ctx, req.(*RollbackLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) SaveLayout(ctx context.Context, in *SaveLayoutRequest, opts ...grpc.CallOption) (*SaveLayoutResponse, error) {
-	out := new(SaveLayoutResponse)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/SaveLayout", in, out, opts...)
-	if err != nil {
+func _Tessellate_DiffLayoutVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffLayoutVersionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).DiffLayoutVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/DiffLayoutVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).DiffLayoutVersions(ctx, req.(*DiffLayoutVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) GetLayout(ctx context.Context, in *LayoutRequest, opts ...grpc.CallOption) (*Layout, error) {
-	out := new(Layout)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetLayout", in, out, opts...)
-	if err != nil {
+func _Tessellate_PromoteLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteLayoutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).PromoteLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/PromoteLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).PromoteLayout(ctx, req.(*PromoteLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) ApplyLayout(ctx context.Context, in *ApplyLayoutRequest, opts ...grpc.CallOption) (*JobStatus, error) {
-	out := new(JobStatus)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/ApplyLayout", in, out, opts...)
-	if err != nil {
+func _Tessellate_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LayoutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).ListPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/ListPromotions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).ListPromotions(ctx, req.(*LayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) DestroyLayout(ctx context.Context, in *DestroyLayoutRequest, opts ...grpc.CallOption) (*JobStatus, error) {
-	out := new(JobStatus)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/DestroyLayout", in, out, opts...)
-	if err != nil {
+func _Tessellate_DeleteLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLayoutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).DeleteLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/DeleteLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).DeleteLayout(ctx, req.(*DeleteLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) AbortJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Ok, error) {
-	out := new(Ok)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/AbortJob", in, out, opts...)
-	if err != nil {
+func _Tessellate_DeleteWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).DeleteWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/DeleteWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).DeleteWorkspace(ctx, req.(*DeleteWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_SaveStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).SaveStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/SaveStack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).SaveStack(ctx, req.(*SaveStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_GetStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).GetStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/GetStack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).GetStack(ctx, req.(*StackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_ApplyStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).ApplyStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/ApplyStack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).ApplyStack(ctx, req.(*StackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_DestroyStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).DestroyStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/DestroyStack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).DestroyStack(ctx, req.(*StackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_GetStackRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StackRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).GetStackRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/GetStackRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).GetStackRun(ctx, req.(*StackRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_RefreshLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).RefreshLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/RefreshLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).RefreshLayout(ctx, req.(*RefreshLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tessellate_ImportResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResourceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).ImportResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/ImportResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).ImportResource(ctx, req.(*ImportResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) StartWatch(ctx context.Context, in *StartWatchRequest, opts ...grpc.CallOption) (*Ok, error) {
-	out := new(Ok)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/StartWatch", in, out, opts...)
-	if err != nil {
+func _Tessellate_AbortJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).AbortJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/AbortJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).AbortJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) StopWatch(ctx context.Context, in *StopWatchRequest, opts ...grpc.CallOption) (*Ok, error) {
-	out := new(Ok)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/StopWatch", in, out, opts...)
-	if err != nil {
+func _Tessellate_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/GetJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).GetJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error) {
-	out := new(GetStateResponse)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetState", in, out, opts...)
-	if err != nil {
+func _Tessellate_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TessellateServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *tessellateClient) GetOutput(ctx context.Context, in *GetOutputRequest, opts ...grpc.CallOption) (*GetOutputResponse, error) {
-	out := new(GetOutputResponse)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetOutput", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _Tessellate_StreamJobLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(TessellateServer).StreamJobLogs(m, &tessellateStreamJobLogsServer{stream})
 }
 
-func (c *tessellateClient) GetAllWorkspaces(ctx context.Context, in *Ok, opts ...grpc.CallOption) (*AllWorkspaces, error) {
-	out := new(AllWorkspaces)
-	err := c.cc.Invoke(ctx, "/tsocial.tessellate.server.Tessellate/GetAllWorkspaces", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Tessellate_StreamJobLogsServer interface {
+	Send(*JobLog) error
+	grpc.ServerStream
 }
 
-// TessellateServer is the server API for Tessellate service.
-type TessellateServer interface {
-	SaveWorkspace(context.Context, *SaveWorkspaceRequest) (*Ok, error)
-	GetWorkspace(context.Context, *GetWorkspaceRequest) (*Workspace, error)
-	GetWorkspaceLayouts(context.Context, *GetWorkspaceLayoutsRequest) (*Layouts, error)
-	SaveLayout(context.Context, *SaveLayoutRequest) (*SaveLayoutResponse, error)
-	GetLayout(context.Context, *LayoutRequest) (*Layout, error)
-	ApplyLayout(context.Context, *ApplyLayoutRequest) (*JobStatus, error)
-	DestroyLayout(context.Context, *DestroyLayoutRequest) (*JobStatus, error)
-	AbortJob(context.Context, *JobRequest) (*Ok, error)
-	StartWatch(context.Context, *StartWatchRequest) (*Ok, error)
-	StopWatch(context.Context, *StopWatchRequest) (*Ok, error)
-	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
-	GetOutput(context.Context, *GetOutputRequest) (*GetOutputResponse, error)
-	GetAllWorkspaces(context.Context, *Ok) (*AllWorkspaces, error)
+type tessellateStreamJobLogsServer struct {
+	grpc.ServerStream
 }
 
-func RegisterTessellateServer(s *grpc.Server, srv TessellateServer) {
-	s.RegisterService(&_Tessellate_serviceDesc, srv)
+func (x *tessellateStreamJobLogsServer) Send(m *JobLog) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _Tessellate_SaveWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveWorkspaceRequest)
+func _Tessellate_GetPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).SaveWorkspace(ctx, in)
+		return srv.(TessellateServer).GetPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/SaveWorkspace",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/GetPlan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).SaveWorkspace(ctx, req.(*SaveWorkspaceRequest))
+		return srv.(TessellateServer).GetPlan(ctx, req.(*JobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tessellate_GetWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkspaceRequest)
+func _Tessellate_StartWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartWatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).GetWorkspace(ctx, in)
+		return srv.(TessellateServer).StartWatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/GetWorkspace",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/StartWatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).GetWorkspace(ctx, req.(*GetWorkspaceRequest))
+		return srv.(TessellateServer).StartWatch(ctx, req.(*StartWatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tessellate_GetWorkspaceLayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkspaceLayoutsRequest)
+func _Tessellate_StopWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopWatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).GetWorkspaceLayouts(ctx, in)
+		return srv.(TessellateServer).StopWatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/GetWorkspaceLayouts",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/StopWatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).GetWorkspaceLayouts(ctx, req.(*GetWorkspaceLayoutsRequest))
+		return srv.(TessellateServer).StopWatch(ctx, req.(*StopWatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tessellate_SaveLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveLayoutRequest)
+func _Tessellate_SetDriftSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriftScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).SaveLayout(ctx, in)
+		return srv.(TessellateServer).SetDriftSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/SaveLayout",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/SetDriftSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).SaveLayout(ctx, req.(*SaveLayoutRequest))
+		return srv.(TessellateServer).SetDriftSchedule(ctx, req.(*DriftScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tessellate_GetLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LayoutRequest)
+func _Tessellate_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).GetLayout(ctx, in)
+		return srv.(TessellateServer).GetState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/GetLayout",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/GetState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).GetLayout(ctx, req.(*LayoutRequest))
+		return srv.(TessellateServer).GetState(ctx, req.(*GetStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tessellate_ApplyLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyLayoutRequest)
+func _Tessellate_ListStateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).ApplyLayout(ctx, in)
+		return srv.(TessellateServer).ListStateVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/ApplyLayout",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/ListStateVersions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).ApplyLayout(ctx, req.(*ApplyLayoutRequest))
+		return srv.(TessellateServer).ListStateVersions(ctx, req.(*GetStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tessellate_DestroyLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DestroyLayoutRequest)
+func _Tessellate_GetStateVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateVersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).DestroyLayout(ctx, in)
+		return srv.(TessellateServer).GetStateVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/DestroyLayout",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/GetStateVersion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).DestroyLayout(ctx, req.(*DestroyLayoutRequest))
+		return srv.(TessellateServer).GetStateVersion(ctx, req.(*StateVersionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tessellate_AbortJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobRequest)
+func _Tessellate_RestoreState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateVersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).AbortJob(ctx, in)
+		return srv.(TessellateServer).RestoreState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/AbortJob",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/RestoreState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).AbortJob(ctx, req.(*JobRequest))
+		return srv.(TessellateServer).RestoreState(ctx, req.(*StateVersionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tessellate_StartWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartWatchRequest)
+func _Tessellate_ListLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLocksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).StartWatch(ctx, in)
+		return srv.(TessellateServer).ListLocks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/StartWatch",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/ListLocks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).StartWatch(ctx, req.(*StartWatchRequest))
+		return srv.(TessellateServer).ListLocks(ctx, req.(*ListLocksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tessellate_StopWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopWatchRequest)
+func _Tessellate_ForceUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceUnlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).StopWatch(ctx, in)
+		return srv.(TessellateServer).ForceUnlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/StopWatch",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/ForceUnlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).StopWatch(ctx, req.(*StopWatchRequest))
+		return srv.(TessellateServer).ForceUnlock(ctx, req.(*ForceUnlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Tessellate_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStateRequest)
+func _Tessellate_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TessellateServer).GetState(ctx, in)
+		return srv.(TessellateServer).ListAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tsocial.tessellate.server.Tessellate/GetState",
+		FullMethod: "/tsocial.tessellate.server.Tessellate/ListAuditEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TessellateServer).GetState(ctx, req.(*GetStateRequest))
+		return srv.(TessellateServer).ListAuditEvents(ctx, req.(*GetWorkspaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1756,6 +5890,24 @@ func _Tessellate_GetOutput_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tessellate_GetSensitiveOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TessellateServer).GetSensitiveOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tsocial.tessellate.server.Tessellate/GetSensitiveOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TessellateServer).GetSensitiveOutput(ctx, req.(*GetOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Tessellate_GetAllWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Ok)
 	if err := dec(in); err != nil {
@@ -1774,6 +5926,53 @@ func _Tessellate_GetAllWorkspaces_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tessellate_ExportWorkspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportWorkspaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TessellateServer).ExportWorkspace(m, &tessellateExportWorkspaceServer{stream})
+}
+
+type Tessellate_ExportWorkspaceServer interface {
+	Send(*ArchiveChunk) error
+	grpc.ServerStream
+}
+
+type tessellateExportWorkspaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *tessellateExportWorkspaceServer) Send(m *ArchiveChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tessellate_ImportWorkspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TessellateServer).ImportWorkspace(&tessellateImportWorkspaceServer{stream})
+}
+
+type Tessellate_ImportWorkspaceServer interface {
+	SendAndClose(*Ok) error
+	Recv() (*ImportWorkspaceRequest, error)
+	grpc.ServerStream
+}
+
+type tessellateImportWorkspaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *tessellateImportWorkspaceServer) SendAndClose(m *Ok) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *tessellateImportWorkspaceServer) Recv() (*ImportWorkspaceRequest, error) {
+	m := new(ImportWorkspaceRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Tessellate_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tsocial.tessellate.server.Tessellate",
 	HandlerType: (*TessellateServer)(nil),
@@ -1786,6 +5985,22 @@ var _Tessellate_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkspace",
 			Handler:    _Tessellate_GetWorkspace_Handler,
 		},
+		{
+			MethodName: "UpdateWorkspaceVars",
+			Handler:    _Tessellate_UpdateWorkspaceVars_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceVarVersions",
+			Handler:    _Tessellate_ListWorkspaceVarVersions_Handler,
+		},
+		{
+			MethodName: "DiffWorkspaceVars",
+			Handler:    _Tessellate_DiffWorkspaceVars_Handler,
+		},
+		{
+			MethodName: "RestoreWorkspaceVars",
+			Handler:    _Tessellate_RestoreWorkspaceVars_Handler,
+		},
 		{
 			MethodName: "GetWorkspaceLayouts",
 			Handler:    _Tessellate_GetWorkspaceLayouts_Handler,
@@ -1806,10 +6021,74 @@ var _Tessellate_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyLayout",
 			Handler:    _Tessellate_DestroyLayout_Handler,
 		},
+		{
+			MethodName: "RollbackLayout",
+			Handler:    _Tessellate_RollbackLayout_Handler,
+		},
+		{
+			MethodName: "DiffLayoutVersions",
+			Handler:    _Tessellate_DiffLayoutVersions_Handler,
+		},
+		{
+			MethodName: "PromoteLayout",
+			Handler:    _Tessellate_PromoteLayout_Handler,
+		},
+		{
+			MethodName: "ListPromotions",
+			Handler:    _Tessellate_ListPromotions_Handler,
+		},
+		{
+			MethodName: "DeleteLayout",
+			Handler:    _Tessellate_DeleteLayout_Handler,
+		},
+		{
+			MethodName: "DeleteWorkspace",
+			Handler:    _Tessellate_DeleteWorkspace_Handler,
+		},
+		{
+			MethodName: "SaveStack",
+			Handler:    _Tessellate_SaveStack_Handler,
+		},
+		{
+			MethodName: "GetStack",
+			Handler:    _Tessellate_GetStack_Handler,
+		},
+		{
+			MethodName: "ApplyStack",
+			Handler:    _Tessellate_ApplyStack_Handler,
+		},
+		{
+			MethodName: "DestroyStack",
+			Handler:    _Tessellate_DestroyStack_Handler,
+		},
+		{
+			MethodName: "GetStackRun",
+			Handler:    _Tessellate_GetStackRun_Handler,
+		},
+		{
+			MethodName: "RefreshLayout",
+			Handler:    _Tessellate_RefreshLayout_Handler,
+		},
+		{
+			MethodName: "ImportResource",
+			Handler:    _Tessellate_ImportResource_Handler,
+		},
 		{
 			MethodName: "AbortJob",
 			Handler:    _Tessellate_AbortJob_Handler,
 		},
+		{
+			MethodName: "GetJob",
+			Handler:    _Tessellate_GetJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _Tessellate_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetPlan",
+			Handler:    _Tessellate_GetPlan_Handler,
+		},
 		{
 			MethodName: "StartWatch",
 			Handler:    _Tessellate_StartWatch_Handler,
@@ -1818,19 +6097,67 @@ var _Tessellate_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopWatch",
 			Handler:    _Tessellate_StopWatch_Handler,
 		},
+		{
+			MethodName: "SetDriftSchedule",
+			Handler:    _Tessellate_SetDriftSchedule_Handler,
+		},
 		{
 			MethodName: "GetState",
 			Handler:    _Tessellate_GetState_Handler,
 		},
+		{
+			MethodName: "ListStateVersions",
+			Handler:    _Tessellate_ListStateVersions_Handler,
+		},
+		{
+			MethodName: "GetStateVersion",
+			Handler:    _Tessellate_GetStateVersion_Handler,
+		},
+		{
+			MethodName: "RestoreState",
+			Handler:    _Tessellate_RestoreState_Handler,
+		},
+		{
+			MethodName: "ListLocks",
+			Handler:    _Tessellate_ListLocks_Handler,
+		},
+		{
+			MethodName: "ForceUnlock",
+			Handler:    _Tessellate_ForceUnlock_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Tessellate_ListAuditEvents_Handler,
+		},
 		{
 			MethodName: "GetOutput",
 			Handler:    _Tessellate_GetOutput_Handler,
 		},
+		{
+			MethodName: "GetSensitiveOutput",
+			Handler:    _Tessellate_GetSensitiveOutput_Handler,
+		},
 		{
 			MethodName: "GetAllWorkspaces",
 			Handler:    _Tessellate_GetAllWorkspaces_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamJobLogs",
+			Handler:       _Tessellate_StreamJobLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportWorkspace",
+			Handler:       _Tessellate_ExportWorkspace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportWorkspace",
+			Handler:       _Tessellate_ImportWorkspace_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "proto/tessellate.proto",
 }
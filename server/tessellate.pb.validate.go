@@ -180,35 +180,34 @@ var _ interface {
 	ErrorName() string
 } = WorkspaceValidationError{}
 
-// Validate checks the field values on AllWorkspaces with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *AllWorkspaces) Validate() error {
+// Validate checks the field values on UpdateWorkspaceVarsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateWorkspaceVarsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetWorkspaces() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AllWorkspacesValidationError{
-					field:  fmt.Sprintf("Workspaces[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return UpdateWorkspaceVarsRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
 		}
+	}
 
+	if len(m.GetVars()) < 1 {
+		return UpdateWorkspaceVarsRequestValidationError{
+			field:  "Vars",
+			reason: "value length must be at least 1 bytes",
+		}
 	}
 
 	return nil
 }
 
-// AllWorkspacesValidationError is the validation error returned by
-// AllWorkspaces.Validate if the designated constraints aren't met.
-type AllWorkspacesValidationError struct {
+// UpdateWorkspaceVarsRequestValidationError is the validation error returned
+// by UpdateWorkspaceVarsRequest.Validate if the designated constraints aren't met.
+type UpdateWorkspaceVarsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -216,22 +215,24 @@ type AllWorkspacesValidationError struct {
 }
 
 // Field function returns field value.
-func (e AllWorkspacesValidationError) Field() string { return e.field }
+func (e UpdateWorkspaceVarsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AllWorkspacesValidationError) Reason() string { return e.reason }
+func (e UpdateWorkspaceVarsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AllWorkspacesValidationError) Cause() error { return e.cause }
+func (e UpdateWorkspaceVarsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AllWorkspacesValidationError) Key() bool { return e.key }
+func (e UpdateWorkspaceVarsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AllWorkspacesValidationError) ErrorName() string { return "AllWorkspacesValidationError" }
+func (e UpdateWorkspaceVarsRequestValidationError) ErrorName() string {
+	return "UpdateWorkspaceVarsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AllWorkspacesValidationError) Error() string {
+func (e UpdateWorkspaceVarsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -243,14 +244,14 @@ func (e AllWorkspacesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAllWorkspaces.%s: %s%s",
+		"invalid %sUpdateWorkspaceVarsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AllWorkspacesValidationError{}
+var _ error = UpdateWorkspaceVarsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -258,36 +259,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AllWorkspacesValidationError{}
+} = UpdateWorkspaceVarsRequestValidationError{}
 
-// Validate checks the field values on Layouts with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Layouts) Validate() error {
+// Validate checks the field values on WorkspaceVarsVersion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *WorkspaceVarsVersion) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetLayouts() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return LayoutsValidationError{
-					field:  fmt.Sprintf("Layouts[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Id
 
 	return nil
 }
 
-// LayoutsValidationError is the validation error returned by Layouts.Validate
-// if the designated constraints aren't met.
-type LayoutsValidationError struct {
+// WorkspaceVarsVersionValidationError is the validation error returned by
+// WorkspaceVarsVersion.Validate if the designated constraints aren't met.
+type WorkspaceVarsVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -295,22 +284,24 @@ type LayoutsValidationError struct {
 }
 
 // Field function returns field value.
-func (e LayoutsValidationError) Field() string { return e.field }
+func (e WorkspaceVarsVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LayoutsValidationError) Reason() string { return e.reason }
+func (e WorkspaceVarsVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LayoutsValidationError) Cause() error { return e.cause }
+func (e WorkspaceVarsVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LayoutsValidationError) Key() bool { return e.key }
+func (e WorkspaceVarsVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LayoutsValidationError) ErrorName() string { return "LayoutsValidationError" }
+func (e WorkspaceVarsVersionValidationError) ErrorName() string {
+	return "WorkspaceVarsVersionValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LayoutsValidationError) Error() string {
+func (e WorkspaceVarsVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -322,14 +313,14 @@ func (e LayoutsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLayouts.%s: %s%s",
+		"invalid %sWorkspaceVarsVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LayoutsValidationError{}
+var _ error = WorkspaceVarsVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -337,29 +328,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LayoutsValidationError{}
+} = WorkspaceVarsVersionValidationError{}
 
-// Validate checks the field values on Layout with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Layout) Validate() error {
+// Validate checks the field values on WorkspaceVarsVersions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *WorkspaceVarsVersions) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Workspaceid
-
-	// no validation rules for Id
-
-	// no validation rules for Plan
-
-	// no validation rules for Status
-
 	return nil
 }
 
-// LayoutValidationError is the validation error returned by Layout.Validate if
-// the designated constraints aren't met.
-type LayoutValidationError struct {
+// WorkspaceVarsVersionsValidationError is the validation error returned by
+// WorkspaceVarsVersions.Validate if the designated constraints aren't met.
+type WorkspaceVarsVersionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -367,22 +351,24 @@ type LayoutValidationError struct {
 }
 
 // Field function returns field value.
-func (e LayoutValidationError) Field() string { return e.field }
+func (e WorkspaceVarsVersionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LayoutValidationError) Reason() string { return e.reason }
+func (e WorkspaceVarsVersionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LayoutValidationError) Cause() error { return e.cause }
+func (e WorkspaceVarsVersionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LayoutValidationError) Key() bool { return e.key }
+func (e WorkspaceVarsVersionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LayoutValidationError) ErrorName() string { return "LayoutValidationError" }
+func (e WorkspaceVarsVersionsValidationError) ErrorName() string {
+	return "WorkspaceVarsVersionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LayoutValidationError) Error() string {
+func (e WorkspaceVarsVersionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -394,14 +380,14 @@ func (e LayoutValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLayout.%s: %s%s",
+		"invalid %sWorkspaceVarsVersions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LayoutValidationError{}
+var _ error = WorkspaceVarsVersionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -409,31 +395,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LayoutValidationError{}
+} = WorkspaceVarsVersionsValidationError{}
 
-// Validate checks the field values on SaveWorkspaceRequest with the rules
+// Validate checks the field values on DiffWorkspaceVarsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *SaveWorkspaceRequest) Validate() error {
+func (m *DiffWorkspaceVarsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	if utf8.RuneCountInString(m.GetId()) < 1 {
-		return SaveWorkspaceRequestValidationError{
+		return DiffWorkspaceVarsRequestValidationError{
 			field:  "Id",
 			reason: "value length must be at least 1 runes",
 		}
 	}
 
-	// no validation rules for Providers
+	// no validation rules for From
+
+	// no validation rules for To
 
 	return nil
 }
 
-// SaveWorkspaceRequestValidationError is the validation error returned by
-// SaveWorkspaceRequest.Validate if the designated constraints aren't met.
-type SaveWorkspaceRequestValidationError struct {
+// DiffWorkspaceVarsRequestValidationError is the validation error returned by
+// DiffWorkspaceVarsRequest.Validate if the designated constraints aren't met.
+type DiffWorkspaceVarsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -441,24 +429,24 @@ type SaveWorkspaceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SaveWorkspaceRequestValidationError) Field() string { return e.field }
+func (e DiffWorkspaceVarsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SaveWorkspaceRequestValidationError) Reason() string { return e.reason }
+func (e DiffWorkspaceVarsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SaveWorkspaceRequestValidationError) Cause() error { return e.cause }
+func (e DiffWorkspaceVarsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SaveWorkspaceRequestValidationError) Key() bool { return e.key }
+func (e DiffWorkspaceVarsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SaveWorkspaceRequestValidationError) ErrorName() string {
-	return "SaveWorkspaceRequestValidationError"
+func (e DiffWorkspaceVarsRequestValidationError) ErrorName() string {
+	return "DiffWorkspaceVarsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SaveWorkspaceRequestValidationError) Error() string {
+func (e DiffWorkspaceVarsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -470,14 +458,14 @@ func (e SaveWorkspaceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSaveWorkspaceRequest.%s: %s%s",
+		"invalid %sDiffWorkspaceVarsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SaveWorkspaceRequestValidationError{}
+var _ error = DiffWorkspaceVarsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -485,29 +473,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SaveWorkspaceRequestValidationError{}
+} = DiffWorkspaceVarsRequestValidationError{}
 
-// Validate checks the field values on GetWorkspaceLayoutsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *GetWorkspaceLayoutsRequest) Validate() error {
+// Validate checks the field values on WorkspaceVarsDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *WorkspaceVarsDiff) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		return GetWorkspaceLayoutsRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
-	}
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for FromVars
+
+	// no validation rules for ToVars
 
 	return nil
 }
 
-// GetWorkspaceLayoutsRequestValidationError is the validation error returned
-// by GetWorkspaceLayoutsRequest.Validate if the designated constraints aren't met.
-type GetWorkspaceLayoutsRequestValidationError struct {
+// WorkspaceVarsDiffValidationError is the validation error returned by
+// WorkspaceVarsDiff.Validate if the designated constraints aren't met.
+type WorkspaceVarsDiffValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -515,24 +504,24 @@ type GetWorkspaceLayoutsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetWorkspaceLayoutsRequestValidationError) Field() string { return e.field }
+func (e WorkspaceVarsDiffValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetWorkspaceLayoutsRequestValidationError) Reason() string { return e.reason }
+func (e WorkspaceVarsDiffValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetWorkspaceLayoutsRequestValidationError) Cause() error { return e.cause }
+func (e WorkspaceVarsDiffValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetWorkspaceLayoutsRequestValidationError) Key() bool { return e.key }
+func (e WorkspaceVarsDiffValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetWorkspaceLayoutsRequestValidationError) ErrorName() string {
-	return "GetWorkspaceLayoutsRequestValidationError"
+func (e WorkspaceVarsDiffValidationError) ErrorName() string {
+	return "WorkspaceVarsDiffValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetWorkspaceLayoutsRequestValidationError) Error() string {
+func (e WorkspaceVarsDiffValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -544,14 +533,14 @@ func (e GetWorkspaceLayoutsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetWorkspaceLayoutsRequest.%s: %s%s",
+		"invalid %sWorkspaceVarsDiff.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetWorkspaceLayoutsRequestValidationError{}
+var _ error = WorkspaceVarsDiffValidationError{}
 
 var _ interface {
 	Field() string
@@ -559,25 +548,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetWorkspaceLayoutsRequestValidationError{}
+} = WorkspaceVarsDiffValidationError{}
 
-// Validate checks the field values on JobStatus with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *JobStatus) Validate() error {
+// Validate checks the field values on RestoreWorkspaceVarsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RestoreWorkspaceVarsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return RestoreWorkspaceVarsRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
 
-	// no validation rules for Status
+	if utf8.RuneCountInString(m.GetVersion()) < 1 {
+		return RestoreWorkspaceVarsRequestValidationError{
+			field:  "Version",
+			reason: "value length must be at least 1 runes",
+		}
+	}
 
 	return nil
 }
 
-// JobStatusValidationError is the validation error returned by
-// JobStatus.Validate if the designated constraints aren't met.
-type JobStatusValidationError struct {
+// RestoreWorkspaceVarsRequestValidationError is the validation error returned
+// by RestoreWorkspaceVarsRequest.Validate if the designated constraints
+// aren't met.
+type RestoreWorkspaceVarsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -585,22 +586,24 @@ type JobStatusValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobStatusValidationError) Field() string { return e.field }
+func (e RestoreWorkspaceVarsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobStatusValidationError) Reason() string { return e.reason }
+func (e RestoreWorkspaceVarsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobStatusValidationError) Cause() error { return e.cause }
+func (e RestoreWorkspaceVarsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobStatusValidationError) Key() bool { return e.key }
+func (e RestoreWorkspaceVarsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobStatusValidationError) ErrorName() string { return "JobStatusValidationError" }
+func (e RestoreWorkspaceVarsRequestValidationError) ErrorName() string {
+	return "RestoreWorkspaceVarsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobStatusValidationError) Error() string {
+func (e RestoreWorkspaceVarsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -612,14 +615,14 @@ func (e JobStatusValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobStatus.%s: %s%s",
+		"invalid %sRestoreWorkspaceVarsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobStatusValidationError{}
+var _ error = RestoreWorkspaceVarsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -627,23 +630,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobStatusValidationError{}
+} = RestoreWorkspaceVarsRequestValidationError{}
 
-// Validate checks the field values on Vars with the rules defined in the proto
-// definition for this message. If any rules are violated, an error is returned.
-func (m *Vars) Validate() error {
+// Validate checks the field values on AllWorkspaces with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AllWorkspaces) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Vars
+	for idx, item := range m.GetWorkspaces() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AllWorkspacesValidationError{
+					field:  fmt.Sprintf("Workspaces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// VarsValidationError is the validation error returned by Vars.Validate if the
-// designated constraints aren't met.
-type VarsValidationError struct {
+// AllWorkspacesValidationError is the validation error returned by
+// AllWorkspaces.Validate if the designated constraints aren't met.
+type AllWorkspacesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -651,22 +668,22 @@ type VarsValidationError struct {
 }
 
 // Field function returns field value.
-func (e VarsValidationError) Field() string { return e.field }
+func (e AllWorkspacesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VarsValidationError) Reason() string { return e.reason }
+func (e AllWorkspacesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VarsValidationError) Cause() error { return e.cause }
+func (e AllWorkspacesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VarsValidationError) Key() bool { return e.key }
+func (e AllWorkspacesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VarsValidationError) ErrorName() string { return "VarsValidationError" }
+func (e AllWorkspacesValidationError) ErrorName() string { return "AllWorkspacesValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VarsValidationError) Error() string {
+func (e AllWorkspacesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -678,14 +695,14 @@ func (e VarsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVars.%s: %s%s",
+		"invalid %sAllWorkspaces.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VarsValidationError{}
+var _ error = AllWorkspacesValidationError{}
 
 var _ interface {
 	Field() string
@@ -693,28 +710,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VarsValidationError{}
+} = AllWorkspacesValidationError{}
 
-// Validate checks the field values on JobRequest with the rules defined in the
+// Validate checks the field values on Layouts with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
-func (m *JobRequest) Validate() error {
+func (m *Layouts) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		return JobRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
+	for idx, item := range m.GetLayouts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LayoutsValidationError{
+					field:  fmt.Sprintf("Layouts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
+
 	}
 
 	return nil
 }
 
-// JobRequestValidationError is the validation error returned by
-// JobRequest.Validate if the designated constraints aren't met.
-type JobRequestValidationError struct {
+// LayoutsValidationError is the validation error returned by Layouts.Validate
+// if the designated constraints aren't met.
+type LayoutsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -722,22 +747,22 @@ type JobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRequestValidationError) Field() string { return e.field }
+func (e LayoutsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRequestValidationError) Reason() string { return e.reason }
+func (e LayoutsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRequestValidationError) Cause() error { return e.cause }
+func (e LayoutsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRequestValidationError) Key() bool { return e.key }
+func (e LayoutsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRequestValidationError) ErrorName() string { return "JobRequestValidationError" }
+func (e LayoutsValidationError) ErrorName() string { return "LayoutsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JobRequestValidationError) Error() string {
+func (e LayoutsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -749,14 +774,14 @@ func (e JobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRequest.%s: %s%s",
+		"invalid %sLayouts.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRequestValidationError{}
+var _ error = LayoutsValidationError{}
 
 var _ interface {
 	Field() string
@@ -764,21 +789,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRequestValidationError{}
+} = LayoutsValidationError{}
 
-// Validate checks the field values on Ok with the rules defined in the proto
-// definition for this message. If any rules are violated, an error is returned.
-func (m *Ok) Validate() error {
+// Validate checks the field values on Layout with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Layout) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for Workspaceid
+
+	// no validation rules for Id
+
+	// no validation rules for Plan
+
+	// no validation rules for Status
+
+	if v, ok := interface{}(m.GetDrift()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LayoutValidationError{
+				field:  "Drift",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SourceWorkspaceId
+
+	// no validation rules for SourceVersion
+
 	return nil
 }
 
-// OkValidationError is the validation error returned by Ok.Validate if the
-// designated constraints aren't met.
-type OkValidationError struct {
+// LayoutValidationError is the validation error returned by Layout.Validate if
+// the designated constraints aren't met.
+type LayoutValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -786,22 +833,22 @@ type OkValidationError struct {
 }
 
 // Field function returns field value.
-func (e OkValidationError) Field() string { return e.field }
+func (e LayoutValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OkValidationError) Reason() string { return e.reason }
+func (e LayoutValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OkValidationError) Cause() error { return e.cause }
+func (e LayoutValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OkValidationError) Key() bool { return e.key }
+func (e LayoutValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OkValidationError) ErrorName() string { return "OkValidationError" }
+func (e LayoutValidationError) ErrorName() string { return "LayoutValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OkValidationError) Error() string {
+func (e LayoutValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -813,14 +860,14 @@ func (e OkValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOk.%s: %s%s",
+		"invalid %sLayout.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OkValidationError{}
+var _ error = LayoutValidationError{}
 
 var _ interface {
 	Field() string
@@ -828,36 +875,113 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OkValidationError{}
+} = LayoutValidationError{}
 
-// Validate checks the field values on LayoutRequest with the rules defined in
+// Validate checks the field values on LayoutDrift with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
-func (m *LayoutRequest) Validate() error {
+func (m *LayoutDrift) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Schedule
+
+	// no validation rules for JobId
+
+	// no validation rules for Drifted
+
+	// no validation rules for CheckedAt
+
+	return nil
+}
+
+// LayoutDriftValidationError is the validation error returned by
+// LayoutDrift.Validate if the designated constraints aren't met.
+type LayoutDriftValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LayoutDriftValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LayoutDriftValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LayoutDriftValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LayoutDriftValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LayoutDriftValidationError) ErrorName() string { return "LayoutDriftValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LayoutDriftValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLayoutDrift.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LayoutDriftValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LayoutDriftValidationError{}
+
+// Validate checks the field values on DiffLayoutVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DiffLayoutVersionsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
-		return LayoutRequestValidationError{
+		return DiffLayoutVersionsRequestValidationError{
 			field:  "WorkspaceId",
 			reason: "value length must be at least 1 runes",
 		}
 	}
 
 	if utf8.RuneCountInString(m.GetId()) < 1 {
-		return LayoutRequestValidationError{
+		return DiffLayoutVersionsRequestValidationError{
 			field:  "Id",
 			reason: "value length must be at least 1 runes",
 		}
 	}
 
+	// no validation rules for From
+
+	// no validation rules for To
+
 	return nil
 }
 
-// LayoutRequestValidationError is the validation error returned by
-// LayoutRequest.Validate if the designated constraints aren't met.
-type LayoutRequestValidationError struct {
+// DiffLayoutVersionsRequestValidationError is the validation error returned by
+// DiffLayoutVersionsRequest.Validate if the designated constraints aren't met.
+type DiffLayoutVersionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -865,22 +989,24 @@ type LayoutRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e LayoutRequestValidationError) Field() string { return e.field }
+func (e DiffLayoutVersionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LayoutRequestValidationError) Reason() string { return e.reason }
+func (e DiffLayoutVersionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LayoutRequestValidationError) Cause() error { return e.cause }
+func (e DiffLayoutVersionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LayoutRequestValidationError) Key() bool { return e.key }
+func (e DiffLayoutVersionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LayoutRequestValidationError) ErrorName() string { return "LayoutRequestValidationError" }
+func (e DiffLayoutVersionsRequestValidationError) ErrorName() string {
+	return "DiffLayoutVersionsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LayoutRequestValidationError) Error() string {
+func (e DiffLayoutVersionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -892,14 +1018,14 @@ func (e LayoutRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLayoutRequest.%s: %s%s",
+		"invalid %sDiffLayoutVersionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LayoutRequestValidationError{}
+var _ error = DiffLayoutVersionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -907,40 +1033,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LayoutRequestValidationError{}
+} = DiffLayoutVersionsRequestValidationError{}
 
-// Validate checks the field values on SaveLayoutRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *SaveLayoutRequest) Validate() error {
+// Validate checks the field values on FileDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *FileDiff) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
-		return SaveLayoutRequestValidationError{
-			field:  "WorkspaceId",
-			reason: "value length must be at least 1 runes",
-		}
+	// no validation rules for Name
+
+	// no validation rules for Change
+
+	return nil
+}
+
+// FileDiffValidationError is the validation error returned by
+// FileDiff.Validate if the designated constraints aren't met.
+type FileDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileDiffValidationError) ErrorName() string { return "FileDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		return SaveLayoutRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	// no validation rules for Plan
+	return fmt.Sprintf(
+		"invalid %sFileDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for Dry
+var _ error = FileDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileDiffValidationError{}
+
+// Validate checks the field values on LayoutDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *LayoutDiff) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LayoutDiffValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// SaveLayoutRequestValidationError is the validation error returned by
-// SaveLayoutRequest.Validate if the designated constraints aren't met.
-type SaveLayoutRequestValidationError struct {
+// LayoutDiffValidationError is the validation error returned by
+// LayoutDiff.Validate if the designated constraints aren't met.
+type LayoutDiffValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -948,24 +1142,22 @@ type SaveLayoutRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SaveLayoutRequestValidationError) Field() string { return e.field }
+func (e LayoutDiffValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SaveLayoutRequestValidationError) Reason() string { return e.reason }
+func (e LayoutDiffValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SaveLayoutRequestValidationError) Cause() error { return e.cause }
+func (e LayoutDiffValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SaveLayoutRequestValidationError) Key() bool { return e.key }
+func (e LayoutDiffValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SaveLayoutRequestValidationError) ErrorName() string {
-	return "SaveLayoutRequestValidationError"
-}
+func (e LayoutDiffValidationError) ErrorName() string { return "LayoutDiffValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SaveLayoutRequestValidationError) Error() string {
+func (e LayoutDiffValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -977,14 +1169,14 @@ func (e SaveLayoutRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSaveLayoutRequest.%s: %s%s",
+		"invalid %sLayoutDiff.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SaveLayoutRequestValidationError{}
+var _ error = LayoutDiffValidationError{}
 
 var _ interface {
 	Field() string
@@ -992,24 +1184,3611 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SaveLayoutRequestValidationError{}
+} = LayoutDiffValidationError{}
 
-// Validate checks the field values on SaveLayoutResponse with the rules
+// Validate checks the field values on PromoteLayoutRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *SaveLayoutResponse) Validate() error {
+func (m *PromoteLayoutRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for LayoutId
+	if utf8.RuneCountInString(m.GetSourceWorkspaceId()) < 1 {
+		return PromoteLayoutRequestValidationError{
+			field:  "SourceWorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return PromoteLayoutRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if !_PromoteLayoutRequest_Version_Pattern.MatchString(m.GetVersion()) {
+		return PromoteLayoutRequestValidationError{
+			field:  "Version",
+			reason: "value does not match regex pattern \"^([0-9]+|latest)?$\"",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetTargetWorkspaceId()) < 1 {
+		return PromoteLayoutRequestValidationError{
+			field:  "TargetWorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
 
 	return nil
 }
 
-// SaveLayoutResponseValidationError is the validation error returned by
-// SaveLayoutResponse.Validate if the designated constraints aren't met.
-type SaveLayoutResponseValidationError struct {
+// PromoteLayoutRequestValidationError is the validation error returned by
+// PromoteLayoutRequest.Validate if the designated constraints aren't met.
+type PromoteLayoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PromoteLayoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PromoteLayoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PromoteLayoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PromoteLayoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PromoteLayoutRequestValidationError) ErrorName() string {
+	return "PromoteLayoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PromoteLayoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPromoteLayoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PromoteLayoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PromoteLayoutRequestValidationError{}
+
+var _PromoteLayoutRequest_Version_Pattern = regexp.MustCompile("^([0-9]+|latest)?$")
+
+// Validate checks the field values on Promotion with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Promotion) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for LayoutId
+
+	// no validation rules for SourceWorkspaceId
+
+	// no validation rules for SourceVersion
+
+	// no validation rules for TargetWorkspaceId
+
+	// no validation rules for Version
+
+	// no validation rules for PromotedBy
+
+	// no validation rules for PromotedAt
+
+	return nil
+}
+
+// PromotionValidationError is the validation error returned by
+// Promotion.Validate if the designated constraints aren't met.
+type PromotionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PromotionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PromotionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PromotionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PromotionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PromotionValidationError) ErrorName() string { return "PromotionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PromotionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPromotion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PromotionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PromotionValidationError{}
+
+// Validate checks the field values on Promotions with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Promotions) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetPromotions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PromotionsValidationError{
+					field:  fmt.Sprintf("Promotions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AppliedVersion
+
+	// no validation rules for AppliedSourceWorkspaceId
+
+	// no validation rules for AppliedSourceVersion
+
+	// no validation rules for LatestSourceVersion
+
+	return nil
+}
+
+// PromotionsValidationError is the validation error returned by
+// Promotions.Validate if the designated constraints aren't met.
+type PromotionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PromotionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PromotionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PromotionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PromotionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PromotionsValidationError) ErrorName() string { return "PromotionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PromotionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPromotions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PromotionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PromotionsValidationError{}
+
+// Validate checks the field values on SaveWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SaveWorkspaceRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return SaveWorkspaceRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Providers
+
+	return nil
+}
+
+// SaveWorkspaceRequestValidationError is the validation error returned by
+// SaveWorkspaceRequest.Validate if the designated constraints aren't met.
+type SaveWorkspaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveWorkspaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveWorkspaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveWorkspaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveWorkspaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveWorkspaceRequestValidationError) ErrorName() string {
+	return "SaveWorkspaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveWorkspaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveWorkspaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveWorkspaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveWorkspaceRequestValidationError{}
+
+// Validate checks the field values on GetWorkspaceLayoutsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetWorkspaceLayoutsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return GetWorkspaceLayoutsRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// GetWorkspaceLayoutsRequestValidationError is the validation error returned
+// by GetWorkspaceLayoutsRequest.Validate if the designated constraints aren't met.
+type GetWorkspaceLayoutsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkspaceLayoutsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkspaceLayoutsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkspaceLayoutsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkspaceLayoutsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkspaceLayoutsRequestValidationError) ErrorName() string {
+	return "GetWorkspaceLayoutsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkspaceLayoutsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkspaceLayoutsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkspaceLayoutsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkspaceLayoutsRequestValidationError{}
+
+// Validate checks the field values on JobStatus with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *JobStatus) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for Link
+
+	// no validation rules for QueuePosition
+
+	return nil
+}
+
+// JobStatusValidationError is the validation error returned by
+// JobStatus.Validate if the designated constraints aren't met.
+type JobStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobStatusValidationError) ErrorName() string { return "JobStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobStatusValidationError{}
+
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Job) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for WorkspaceId
+
+	// no validation rules for LayoutId
+
+	// no validation rules for LayoutVersion
+
+	// no validation rules for VarsVersion
+
+	// no validation rules for Op
+
+	// no validation rules for Dry
+
+	// no validation rules for Retry
+
+	// no validation rules for Status
+
+	// no validation rules for Error
+
+	// no validation rules for StartedAt
+
+	// no validation rules for EndedAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for PlanJobId
+
+	// no validation rules for StateSerial
+
+	// no validation rules for QueuePosition
+
+	// no validation rules for Drift
+
+	// no validation rules for ImportAddress
+
+	// no validation rules for ImportId
+
+	return nil
+}
+
+// JobValidationError is the validation error returned by Job.Validate if the
+// designated constraints aren't met.
+type JobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobValidationError{}
+
+// Validate checks the field values on ListJobsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListJobsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return ListJobsRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLayoutId()) < 1 {
+		return ListJobsRequestValidationError{
+			field:  "LayoutId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for PageToken
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		return ListJobsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+	}
+
+	return nil
+}
+
+// ListJobsRequestValidationError is the validation error returned by
+// ListJobsRequest.Validate if the designated constraints aren't met.
+type ListJobsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListJobsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListJobsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListJobsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListJobsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListJobsRequestValidationError) ErrorName() string { return "ListJobsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListJobsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListJobsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListJobsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListJobsRequestValidationError{}
+
+// Validate checks the field values on Jobs with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Jobs) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobsValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// JobsValidationError is the validation error returned by Jobs.Validate if the
+// designated constraints aren't met.
+type JobsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobsValidationError) ErrorName() string { return "JobsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobs.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobsValidationError{}
+
+// Validate checks the field values on JobLog with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *JobLog) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Data
+
+	return nil
+}
+
+// JobLogValidationError is the validation error returned by JobLog.Validate if
+// the designated constraints aren't met.
+type JobLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobLogValidationError) ErrorName() string { return "JobLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobLogValidationError{}
+
+// Validate checks the field values on ResourceChange with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ResourceChange) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Address
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for Action
+
+	return nil
+}
+
+// ResourceChangeValidationError is the validation error returned by
+// ResourceChange.Validate if the designated constraints aren't met.
+type ResourceChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceChangeValidationError) ErrorName() string { return "ResourceChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceChangeValidationError{}
+
+// Validate checks the field values on Plan with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Plan) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for JobId
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Add
+
+	// no validation rules for Change
+
+	// no validation rules for Destroy
+
+	return nil
+}
+
+// PlanValidationError is the validation error returned by Plan.Validate if the
+// designated constraints aren't met.
+type PlanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanValidationError) ErrorName() string { return "PlanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanValidationError{}
+
+// Validate checks the field values on Vars with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Vars) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Vars
+
+	return nil
+}
+
+// VarsValidationError is the validation error returned by Vars.Validate if the
+// designated constraints aren't met.
+type VarsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VarsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VarsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VarsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VarsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VarsValidationError) ErrorName() string { return "VarsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VarsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVars.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VarsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VarsValidationError{}
+
+// Validate checks the field values on JobRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *JobRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return JobRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return JobRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLayoutId()) < 1 {
+		return JobRequestValidationError{
+			field:  "LayoutId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// JobRequestValidationError is the validation error returned by
+// JobRequest.Validate if the designated constraints aren't met.
+type JobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRequestValidationError) ErrorName() string { return "JobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRequestValidationError{}
+
+// Validate checks the field values on Ok with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Ok) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// OkValidationError is the validation error returned by Ok.Validate if the
+// designated constraints aren't met.
+type OkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OkValidationError) ErrorName() string { return "OkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OkValidationError{}
+
+// Validate checks the field values on LayoutRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *LayoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return LayoutRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return LayoutRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// LayoutRequestValidationError is the validation error returned by
+// LayoutRequest.Validate if the designated constraints aren't met.
+type LayoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LayoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LayoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LayoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LayoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LayoutRequestValidationError) ErrorName() string { return "LayoutRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LayoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLayoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LayoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LayoutRequestValidationError{}
+
+// Validate checks the field values on SaveLayoutRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *SaveLayoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return SaveLayoutRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return SaveLayoutRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Plan
+
+	// no validation rules for Dry
+
+	return nil
+}
+
+// SaveLayoutRequestValidationError is the validation error returned by
+// SaveLayoutRequest.Validate if the designated constraints aren't met.
+type SaveLayoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveLayoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveLayoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveLayoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveLayoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveLayoutRequestValidationError) ErrorName() string {
+	return "SaveLayoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveLayoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveLayoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveLayoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveLayoutRequestValidationError{}
+
+// Validate checks the field values on SaveLayoutResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SaveLayoutResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for LayoutId
+
+	return nil
+}
+
+// SaveLayoutResponseValidationError is the validation error returned by
+// SaveLayoutResponse.Validate if the designated constraints aren't met.
+type SaveLayoutResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveLayoutResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveLayoutResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveLayoutResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveLayoutResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveLayoutResponseValidationError) ErrorName() string {
+	return "SaveLayoutResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveLayoutResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveLayoutResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveLayoutResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveLayoutResponseValidationError{}
+
+// Validate checks the field values on SetLayoutStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetLayoutStatusRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return SetLayoutStatusRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return SetLayoutStatusRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Status
+
+	return nil
+}
+
+// SetLayoutStatusRequestValidationError is the validation error returned by
+// SetLayoutStatusRequest.Validate if the designated constraints aren't met.
+type SetLayoutStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLayoutStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLayoutStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLayoutStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLayoutStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLayoutStatusRequestValidationError) ErrorName() string {
+	return "SetLayoutStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetLayoutStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLayoutStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLayoutStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLayoutStatusRequestValidationError{}
+
+// Validate checks the field values on ApplyLayoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplyLayoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return ApplyLayoutRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return ApplyLayoutRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Vars
+
+	// no validation rules for Dry
+
+	if m.GetRetry() < 0 {
+		return ApplyLayoutRequestValidationError{
+			field:  "Retry",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	// no validation rules for PlanJobId
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if !_ApplyLayoutRequest_Targets_Pattern.MatchString(item) {
+			return ApplyLayoutRequestValidationError{
+				field:  fmt.Sprintf("Targets[%v]", idx),
+				reason: "value does not match regex pattern \"^(module\\\\.[\\\\w-]+(\\\\[[^\\\\]]+\\\\])?\\\\.)*(module\\\\.[\\\\w-]+|(data\\\\.)?[\\\\w-]+\\\\.[\\\\w-]+)(\\\\[[^\\\\]]+\\\\])?$\"",
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetReplace() {
+		_, _ = idx, item
+
+		if !_ApplyLayoutRequest_Replace_Pattern.MatchString(item) {
+			return ApplyLayoutRequestValidationError{
+				field:  fmt.Sprintf("Replace[%v]", idx),
+				reason: "value does not match regex pattern \"^(module\\\\.[\\\\w-]+(\\\\[[^\\\\]]+\\\\])?\\\\.)*[\\\\w-]+\\\\.[\\\\w-]+(\\\\[[^\\\\]]+\\\\])?$\"",
+			}
+		}
+
+	}
+
+	// no validation rules for LayoutVersion
+
+	return nil
+}
+
+// ApplyLayoutRequestValidationError is the validation error returned by
+// ApplyLayoutRequest.Validate if the designated constraints aren't met.
+type ApplyLayoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyLayoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyLayoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyLayoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyLayoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyLayoutRequestValidationError) ErrorName() string {
+	return "ApplyLayoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyLayoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyLayoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyLayoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyLayoutRequestValidationError{}
+
+var _ApplyLayoutRequest_Targets_Pattern = regexp.MustCompile("^(module\\.[\\w-]+(\\[[^\\]]+\\])?\\.)*(module\\.[\\w-]+|(data\\.)?[\\w-]+\\.[\\w-]+)(\\[[^\\]]+\\])?$")
+
+var _ApplyLayoutRequest_Replace_Pattern = regexp.MustCompile("^(module\\.[\\w-]+(\\[[^\\]]+\\])?\\.)*[\\w-]+\\.[\\w-]+(\\[[^\\]]+\\])?$")
+
+// Validate checks the field values on RollbackLayoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RollbackLayoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return RollbackLayoutRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return RollbackLayoutRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for JobId
+
+	if m.GetRetry() < 0 {
+		return RollbackLayoutRequestValidationError{
+			field:  "Retry",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	return nil
+}
+
+// RollbackLayoutRequestValidationError is the validation error returned by
+// RollbackLayoutRequest.Validate if the designated constraints aren't met.
+type RollbackLayoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackLayoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackLayoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackLayoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackLayoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackLayoutRequestValidationError) ErrorName() string {
+	return "RollbackLayoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackLayoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackLayoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackLayoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackLayoutRequestValidationError{}
+
+// Validate checks the field values on DestroyLayoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DestroyLayoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return DestroyLayoutRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return DestroyLayoutRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Vars
+
+	if m.GetRetry() < 0 {
+		return DestroyLayoutRequestValidationError{
+			field:  "Retry",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if !_DestroyLayoutRequest_Targets_Pattern.MatchString(item) {
+			return DestroyLayoutRequestValidationError{
+				field:  fmt.Sprintf("Targets[%v]", idx),
+				reason: "value does not match regex pattern \"^(module\\\\.[\\\\w-]+(\\\\[[^\\\\]]+\\\\])?\\\\.)*(module\\\\.[\\\\w-]+|(data\\\\.)?[\\\\w-]+\\\\.[\\\\w-]+)(\\\\[[^\\\\]]+\\\\])?$\"",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// DestroyLayoutRequestValidationError is the validation error returned by
+// DestroyLayoutRequest.Validate if the designated constraints aren't met.
+type DestroyLayoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DestroyLayoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DestroyLayoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DestroyLayoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DestroyLayoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DestroyLayoutRequestValidationError) ErrorName() string {
+	return "DestroyLayoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DestroyLayoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDestroyLayoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DestroyLayoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DestroyLayoutRequestValidationError{}
+
+var _DestroyLayoutRequest_Targets_Pattern = regexp.MustCompile("^(module\\.[\\w-]+(\\[[^\\]]+\\])?\\.)*(module\\.[\\w-]+|(data\\.)?[\\w-]+\\.[\\w-]+)(\\[[^\\]]+\\])?$")
+
+// Validate checks the field values on DeleteLayoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteLayoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return DeleteLayoutRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return DeleteLayoutRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Force
+
+	return nil
+}
+
+// DeleteLayoutRequestValidationError is the validation error returned by
+// DeleteLayoutRequest.Validate if the designated constraints aren't met.
+type DeleteLayoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteLayoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteLayoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteLayoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteLayoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteLayoutRequestValidationError) ErrorName() string {
+	return "DeleteLayoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteLayoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteLayoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteLayoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteLayoutRequestValidationError{}
+
+// Validate checks the field values on DeleteWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteWorkspaceRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return DeleteWorkspaceRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// DeleteWorkspaceRequestValidationError is the validation error returned by
+// DeleteWorkspaceRequest.Validate if the designated constraints aren't met.
+type DeleteWorkspaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWorkspaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWorkspaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWorkspaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWorkspaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWorkspaceRequestValidationError) ErrorName() string {
+	return "DeleteWorkspaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWorkspaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWorkspaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWorkspaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWorkspaceRequestValidationError{}
+
+// Validate checks the field values on StackLayout with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *StackLayout) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return StackLayoutValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// StackLayoutValidationError is the validation error returned by
+// StackLayout.Validate if the designated constraints aren't met.
+type StackLayoutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StackLayoutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StackLayoutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StackLayoutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StackLayoutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StackLayoutValidationError) ErrorName() string { return "StackLayoutValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StackLayoutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStackLayout.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StackLayoutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StackLayoutValidationError{}
+
+// Validate checks the field values on SaveStackRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *SaveStackRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return SaveStackRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return SaveStackRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if len(m.GetLayouts()) < 1 {
+		return SaveStackRequestValidationError{
+			field:  "Layouts",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetLayouts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SaveStackRequestValidationError{
+					field:  fmt.Sprintf("Layouts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SaveStackRequestValidationError is the validation error returned by
+// SaveStackRequest.Validate if the designated constraints aren't met.
+type SaveStackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveStackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveStackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveStackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveStackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveStackRequestValidationError) ErrorName() string { return "SaveStackRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SaveStackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveStackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveStackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveStackRequestValidationError{}
+
+// Validate checks the field values on StackRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *StackRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return StackRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return StackRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if m.GetRetry() < 0 {
+		return StackRequestValidationError{
+			field:  "Retry",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	return nil
+}
+
+// StackRequestValidationError is the validation error returned by
+// StackRequest.Validate if the designated constraints aren't met.
+type StackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StackRequestValidationError) ErrorName() string { return "StackRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StackRequestValidationError{}
+
+// Validate checks the field values on StackLayer with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *StackLayer) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// StackLayerValidationError is the validation error returned by
+// StackLayer.Validate if the designated constraints aren't met.
+type StackLayerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StackLayerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StackLayerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StackLayerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StackLayerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StackLayerValidationError) ErrorName() string { return "StackLayerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StackLayerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStackLayer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StackLayerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StackLayerValidationError{}
+
+// Validate checks the field values on Stack with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Stack) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for WorkspaceId
+
+	// no validation rules for Id
+
+	for idx, item := range m.GetLayouts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StackValidationError{
+					field:  fmt.Sprintf("Layouts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetLayers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StackValidationError{
+					field:  fmt.Sprintf("Layers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LastRunId
+
+	return nil
+}
+
+// StackValidationError is the validation error returned by Stack.Validate if
+// the designated constraints aren't met.
+type StackValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StackValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StackValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StackValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StackValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StackValidationError) ErrorName() string { return "StackValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StackValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStack.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StackValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StackValidationError{}
+
+// Validate checks the field values on StackRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *StackRunRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return StackRunRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetStackId()) < 1 {
+		return StackRunRequestValidationError{
+			field:  "StackId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// StackRunRequestValidationError is the validation error returned by
+// StackRunRequest.Validate if the designated constraints aren't met.
+type StackRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StackRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StackRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StackRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StackRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StackRunRequestValidationError) ErrorName() string { return "StackRunRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StackRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStackRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StackRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StackRunRequestValidationError{}
+
+// Validate checks the field values on StackJob with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *StackJob) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for LayoutId
+
+	// no validation rules for JobId
+
+	// no validation rules for Status
+
+	// no validation rules for Layer
+
+	return nil
+}
+
+// StackJobValidationError is the validation error returned by
+// StackJob.Validate if the designated constraints aren't met.
+type StackJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StackJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StackJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StackJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StackJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StackJobValidationError) ErrorName() string { return "StackJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StackJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStackJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StackJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StackJobValidationError{}
+
+// Validate checks the field values on StackRun with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *StackRun) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for StackId
+
+	// no validation rules for Op
+
+	// no validation rules for Status
+
+	// no validation rules for Layer
+
+	for idx, item := range m.GetLayers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StackRunValidationError{
+					field:  fmt.Sprintf("Layers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StackRunValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Error
+
+	return nil
+}
+
+// StackRunValidationError is the validation error returned by
+// StackRun.Validate if the designated constraints aren't met.
+type StackRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StackRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StackRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StackRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StackRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StackRunValidationError) ErrorName() string { return "StackRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StackRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStackRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StackRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StackRunValidationError{}
+
+// Validate checks the field values on RefreshLayoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RefreshLayoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return RefreshLayoutRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return RefreshLayoutRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Vars
+
+	if m.GetRetry() < 0 {
+		return RefreshLayoutRequestValidationError{
+			field:  "Retry",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if !_RefreshLayoutRequest_Targets_Pattern.MatchString(item) {
+			return RefreshLayoutRequestValidationError{
+				field:  fmt.Sprintf("Targets[%v]", idx),
+				reason: "value does not match regex pattern \"^(module\\\\.[\\\\w-]+(\\\\[[^\\\\]]+\\\\])?\\\\.)*(module\\\\.[\\\\w-]+|(data\\\\.)?[\\\\w-]+\\\\.[\\\\w-]+)(\\\\[[^\\\\]]+\\\\])?$\"",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// RefreshLayoutRequestValidationError is the validation error returned by
+// RefreshLayoutRequest.Validate if the designated constraints aren't met.
+type RefreshLayoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshLayoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshLayoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshLayoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshLayoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshLayoutRequestValidationError) ErrorName() string {
+	return "RefreshLayoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshLayoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshLayoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshLayoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshLayoutRequestValidationError{}
+
+var _RefreshLayoutRequest_Targets_Pattern = regexp.MustCompile("^(module\\.[\\w-]+(\\[[^\\]]+\\])?\\.)*(module\\.[\\w-]+|(data\\.)?[\\w-]+\\.[\\w-]+)(\\[[^\\]]+\\])?$")
+
+// Validate checks the field values on ImportResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportResourceRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return ImportResourceRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return ImportResourceRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Vars
+
+	if m.GetRetry() < 0 {
+		return ImportResourceRequestValidationError{
+			field:  "Retry",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	if !_ImportResourceRequest_Address_Pattern.MatchString(m.GetAddress()) {
+		return ImportResourceRequestValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^(module\\\\.[\\\\w-]+(\\\\[[^\\\\]]+\\\\])?\\\\.)*[\\\\w-]+\\\\.[\\\\w-]+(\\\\[[^\\\\]]+\\\\])?$\"",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetResourceId()) < 1 {
+		return ImportResourceRequestValidationError{
+			field:  "ResourceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// ImportResourceRequestValidationError is the validation error returned by
+// ImportResourceRequest.Validate if the designated constraints aren't met.
+type ImportResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportResourceRequestValidationError) ErrorName() string {
+	return "ImportResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportResourceRequestValidationError{}
+
+var _ImportResourceRequest_Address_Pattern = regexp.MustCompile("^(module\\.[\\w-]+(\\[[^\\]]+\\])?\\.)*[\\w-]+\\.[\\w-]+(\\[[^\\]]+\\])?$")
+
+// Validate checks the field values on StartWatchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *StartWatchRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return StartWatchRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return StartWatchRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for SuccessCallback
+
+	// no validation rules for FailureCallback
+
+	return nil
+}
+
+// StartWatchRequestValidationError is the validation error returned by
+// StartWatchRequest.Validate if the designated constraints aren't met.
+type StartWatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartWatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartWatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartWatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartWatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartWatchRequestValidationError) ErrorName() string {
+	return "StartWatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartWatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartWatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartWatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartWatchRequestValidationError{}
+
+// Validate checks the field values on DriftScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DriftScheduleRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return DriftScheduleRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return DriftScheduleRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Cron
+
+	return nil
+}
+
+// DriftScheduleRequestValidationError is the validation error returned by
+// DriftScheduleRequest.Validate if the designated constraints aren't met.
+type DriftScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DriftScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DriftScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DriftScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DriftScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DriftScheduleRequestValidationError) ErrorName() string {
+	return "DriftScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DriftScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDriftScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DriftScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DriftScheduleRequestValidationError{}
+
+// Validate checks the field values on StopWatchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *StopWatchRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return StopWatchRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return StopWatchRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// StopWatchRequestValidationError is the validation error returned by
+// StopWatchRequest.Validate if the designated constraints aren't met.
+type StopWatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StopWatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StopWatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StopWatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StopWatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StopWatchRequestValidationError) ErrorName() string { return "StopWatchRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StopWatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStopWatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StopWatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StopWatchRequestValidationError{}
+
+// Validate checks the field values on GetStateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetStateRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return GetStateRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLayoutId()) < 1 {
+		return GetStateRequestValidationError{
+			field:  "LayoutId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// GetStateRequestValidationError is the validation error returned by
+// GetStateRequest.Validate if the designated constraints aren't met.
+type GetStateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStateRequestValidationError) ErrorName() string { return "GetStateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetStateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStateRequestValidationError{}
+
+// Validate checks the field values on GetStateResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetStateResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for State
+
+	return nil
+}
+
+// GetStateResponseValidationError is the validation error returned by
+// GetStateResponse.Validate if the designated constraints aren't met.
+type GetStateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStateResponseValidationError) ErrorName() string { return "GetStateResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetStateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStateResponseValidationError{}
+
+// Validate checks the field values on StateVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *StateVersion) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for JobId
+
+	// no validation rules for Stage
+
+	// no validation rules for Serial
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for RestoredFrom
+
+	// no validation rules for RestoredBy
+
+	return nil
+}
+
+// StateVersionValidationError is the validation error returned by
+// StateVersion.Validate if the designated constraints aren't met.
+type StateVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StateVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StateVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StateVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StateVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StateVersionValidationError) ErrorName() string { return "StateVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StateVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStateVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StateVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StateVersionValidationError{}
+
+// Validate checks the field values on StateVersions with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *StateVersions) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StateVersionsValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// StateVersionsValidationError is the validation error returned by
+// StateVersions.Validate if the designated constraints aren't met.
+type StateVersionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StateVersionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StateVersionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StateVersionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StateVersionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StateVersionsValidationError) ErrorName() string { return "StateVersionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StateVersionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStateVersions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StateVersionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StateVersionsValidationError{}
+
+// Validate checks the field values on LayoutLock with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *LayoutLock) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for WorkspaceId
+
+	// no validation rules for LayoutId
+
+	// no validation rules for JobId
+
+	// no validation rules for LockedAt
+
+	// no validation rules for AgeSeconds
+
+	return nil
+}
+
+// LayoutLockValidationError is the validation error returned by
+// LayoutLock.Validate if the designated constraints aren't met.
+type LayoutLockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LayoutLockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LayoutLockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LayoutLockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LayoutLockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LayoutLockValidationError) ErrorName() string { return "LayoutLockValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LayoutLockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLayoutLock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LayoutLockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LayoutLockValidationError{}
+
+// Validate checks the field values on ListLocksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListLocksRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for WorkspaceId
+
+	return nil
+}
+
+// ListLocksRequestValidationError is the validation error returned by
+// ListLocksRequest.Validate if the designated constraints aren't met.
+type ListLocksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLocksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLocksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLocksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLocksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLocksRequestValidationError) ErrorName() string { return "ListLocksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListLocksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLocksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLocksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLocksRequestValidationError{}
+
+// Validate checks the field values on LayoutLocks with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *LayoutLocks) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetLocks() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LayoutLocksValidationError{
+					field:  fmt.Sprintf("Locks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// LayoutLocksValidationError is the validation error returned by
+// LayoutLocks.Validate if the designated constraints aren't met.
+type LayoutLocksValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1017,24 +4796,22 @@ type SaveLayoutResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SaveLayoutResponseValidationError) Field() string { return e.field }
+func (e LayoutLocksValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SaveLayoutResponseValidationError) Reason() string { return e.reason }
+func (e LayoutLocksValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SaveLayoutResponseValidationError) Cause() error { return e.cause }
+func (e LayoutLocksValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SaveLayoutResponseValidationError) Key() bool { return e.key }
+func (e LayoutLocksValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SaveLayoutResponseValidationError) ErrorName() string {
-	return "SaveLayoutResponseValidationError"
-}
+func (e LayoutLocksValidationError) ErrorName() string { return "LayoutLocksValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SaveLayoutResponseValidationError) Error() string {
+func (e LayoutLocksValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1046,14 +4823,14 @@ func (e SaveLayoutResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSaveLayoutResponse.%s: %s%s",
+		"invalid %sLayoutLocks.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SaveLayoutResponseValidationError{}
+var _ error = LayoutLocksValidationError{}
 
 var _ interface {
 	Field() string
@@ -1061,38 +4838,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SaveLayoutResponseValidationError{}
+} = LayoutLocksValidationError{}
 
-// Validate checks the field values on SetLayoutStatusRequest with the rules
+// Validate checks the field values on ForceUnlockRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *SetLayoutStatusRequest) Validate() error {
+func (m *ForceUnlockRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
-		return SetLayoutStatusRequestValidationError{
+		return ForceUnlockRequestValidationError{
 			field:  "WorkspaceId",
 			reason: "value length must be at least 1 runes",
 		}
 	}
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		return SetLayoutStatusRequestValidationError{
-			field:  "Id",
+	if utf8.RuneCountInString(m.GetLayoutId()) < 1 {
+		return ForceUnlockRequestValidationError{
+			field:  "LayoutId",
 			reason: "value length must be at least 1 runes",
 		}
 	}
 
-	// no validation rules for Status
+	if utf8.RuneCountInString(m.GetReason()) < 1 {
+		return ForceUnlockRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at least 1 runes",
+		}
+	}
 
 	return nil
 }
 
-// SetLayoutStatusRequestValidationError is the validation error returned by
-// SetLayoutStatusRequest.Validate if the designated constraints aren't met.
-type SetLayoutStatusRequestValidationError struct {
+// ForceUnlockRequestValidationError is the validation error returned by
+// ForceUnlockRequest.Validate if the designated constraints aren't met.
+type ForceUnlockRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1100,24 +4882,24 @@ type SetLayoutStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetLayoutStatusRequestValidationError) Field() string { return e.field }
+func (e ForceUnlockRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetLayoutStatusRequestValidationError) Reason() string { return e.reason }
+func (e ForceUnlockRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetLayoutStatusRequestValidationError) Cause() error { return e.cause }
+func (e ForceUnlockRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetLayoutStatusRequestValidationError) Key() bool { return e.key }
+func (e ForceUnlockRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetLayoutStatusRequestValidationError) ErrorName() string {
-	return "SetLayoutStatusRequestValidationError"
+func (e ForceUnlockRequestValidationError) ErrorName() string {
+	return "ForceUnlockRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetLayoutStatusRequestValidationError) Error() string {
+func (e ForceUnlockRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1129,14 +4911,14 @@ func (e SetLayoutStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetLayoutStatusRequest.%s: %s%s",
+		"invalid %sForceUnlockRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetLayoutStatusRequestValidationError{}
+var _ error = ForceUnlockRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1144,47 +4926,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetLayoutStatusRequestValidationError{}
+} = ForceUnlockRequestValidationError{}
 
-// Validate checks the field values on ApplyLayoutRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *ApplyLayoutRequest) Validate() error {
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *AuditEvent) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
-		return ApplyLayoutRequestValidationError{
-			field:  "WorkspaceId",
-			reason: "value length must be at least 1 runes",
-		}
-	}
+	// no validation rules for Id
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		return ApplyLayoutRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
-	}
+	// no validation rules for Action
 
-	// no validation rules for Vars
+	// no validation rules for Actor
 
-	// no validation rules for Dry
+	// no validation rules for WorkspaceId
 
-	if m.GetRetry() < 0 {
-		return ApplyLayoutRequestValidationError{
-			field:  "Retry",
-			reason: "value must be greater than or equal to 0",
-		}
-	}
+	// no validation rules for LayoutId
+
+	// no validation rules for Detail
+
+	// no validation rules for CreatedAt
 
 	return nil
 }
 
-// ApplyLayoutRequestValidationError is the validation error returned by
-// ApplyLayoutRequest.Validate if the designated constraints aren't met.
-type ApplyLayoutRequestValidationError struct {
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1192,24 +4962,22 @@ type ApplyLayoutRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApplyLayoutRequestValidationError) Field() string { return e.field }
+func (e AuditEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApplyLayoutRequestValidationError) Reason() string { return e.reason }
+func (e AuditEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApplyLayoutRequestValidationError) Cause() error { return e.cause }
+func (e AuditEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApplyLayoutRequestValidationError) Key() bool { return e.key }
+func (e AuditEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApplyLayoutRequestValidationError) ErrorName() string {
-	return "ApplyLayoutRequestValidationError"
-}
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ApplyLayoutRequestValidationError) Error() string {
+func (e AuditEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1221,14 +4989,14 @@ func (e ApplyLayoutRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApplyLayoutRequest.%s: %s%s",
+		"invalid %sAuditEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApplyLayoutRequestValidationError{}
+var _ error = AuditEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1236,45 +5004,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApplyLayoutRequestValidationError{}
+} = AuditEventValidationError{}
 
-// Validate checks the field values on DestroyLayoutRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *DestroyLayoutRequest) Validate() error {
+// Validate checks the field values on AuditEvents with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AuditEvents) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
-		return DestroyLayoutRequestValidationError{
-			field:  "WorkspaceId",
-			reason: "value length must be at least 1 runes",
-		}
-	}
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		return DestroyLayoutRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditEventsValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-	}
-
-	// no validation rules for Vars
 
-	if m.GetRetry() < 0 {
-		return DestroyLayoutRequestValidationError{
-			field:  "Retry",
-			reason: "value must be greater than or equal to 0",
-		}
 	}
 
 	return nil
 }
 
-// DestroyLayoutRequestValidationError is the validation error returned by
-// DestroyLayoutRequest.Validate if the designated constraints aren't met.
-type DestroyLayoutRequestValidationError struct {
+// AuditEventsValidationError is the validation error returned by
+// AuditEvents.Validate if the designated constraints aren't met.
+type AuditEventsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1282,24 +5042,22 @@ type DestroyLayoutRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DestroyLayoutRequestValidationError) Field() string { return e.field }
+func (e AuditEventsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DestroyLayoutRequestValidationError) Reason() string { return e.reason }
+func (e AuditEventsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DestroyLayoutRequestValidationError) Cause() error { return e.cause }
+func (e AuditEventsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DestroyLayoutRequestValidationError) Key() bool { return e.key }
+func (e AuditEventsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DestroyLayoutRequestValidationError) ErrorName() string {
-	return "DestroyLayoutRequestValidationError"
-}
+func (e AuditEventsValidationError) ErrorName() string { return "AuditEventsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DestroyLayoutRequestValidationError) Error() string {
+func (e AuditEventsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1311,14 +5069,14 @@ func (e DestroyLayoutRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDestroyLayoutRequest.%s: %s%s",
+		"invalid %sAuditEvents.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DestroyLayoutRequestValidationError{}
+var _ error = AuditEventsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1326,40 +5084,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DestroyLayoutRequestValidationError{}
+} = AuditEventsValidationError{}
 
-// Validate checks the field values on StartWatchRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *StartWatchRequest) Validate() error {
+// Validate checks the field values on ExportWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportWorkspaceRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
-		return StartWatchRequestValidationError{
-			field:  "WorkspaceId",
-			reason: "value length must be at least 1 runes",
-		}
-	}
-
 	if utf8.RuneCountInString(m.GetId()) < 1 {
-		return StartWatchRequestValidationError{
+		return ExportWorkspaceRequestValidationError{
 			field:  "Id",
 			reason: "value length must be at least 1 runes",
 		}
 	}
 
-	// no validation rules for SuccessCallback
-
-	// no validation rules for FailureCallback
+	// no validation rules for Key
 
 	return nil
 }
 
-// StartWatchRequestValidationError is the validation error returned by
-// StartWatchRequest.Validate if the designated constraints aren't met.
-type StartWatchRequestValidationError struct {
+// ExportWorkspaceRequestValidationError is the validation error returned by
+// ExportWorkspaceRequest.Validate if the designated constraints aren't met.
+type ExportWorkspaceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1367,24 +5116,24 @@ type StartWatchRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartWatchRequestValidationError) Field() string { return e.field }
+func (e ExportWorkspaceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartWatchRequestValidationError) Reason() string { return e.reason }
+func (e ExportWorkspaceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartWatchRequestValidationError) Cause() error { return e.cause }
+func (e ExportWorkspaceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartWatchRequestValidationError) Key() bool { return e.key }
+func (e ExportWorkspaceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartWatchRequestValidationError) ErrorName() string {
-	return "StartWatchRequestValidationError"
+func (e ExportWorkspaceRequestValidationError) ErrorName() string {
+	return "ExportWorkspaceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartWatchRequestValidationError) Error() string {
+func (e ExportWorkspaceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1396,14 +5145,14 @@ func (e StartWatchRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartWatchRequest.%s: %s%s",
+		"invalid %sExportWorkspaceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartWatchRequestValidationError{}
+var _ error = ExportWorkspaceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1411,36 +5160,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartWatchRequestValidationError{}
+} = ExportWorkspaceRequestValidationError{}
 
-// Validate checks the field values on StopWatchRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *StopWatchRequest) Validate() error {
+// Validate checks the field values on ArchiveChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ArchiveChunk) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
-		return StopWatchRequestValidationError{
-			field:  "WorkspaceId",
-			reason: "value length must be at least 1 runes",
-		}
-	}
-
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		return StopWatchRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
-	}
+	// no validation rules for Data
 
 	return nil
 }
 
-// StopWatchRequestValidationError is the validation error returned by
-// StopWatchRequest.Validate if the designated constraints aren't met.
-type StopWatchRequestValidationError struct {
+// ArchiveChunkValidationError is the validation error returned by
+// ArchiveChunk.Validate if the designated constraints aren't met.
+type ArchiveChunkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1448,22 +5185,22 @@ type StopWatchRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StopWatchRequestValidationError) Field() string { return e.field }
+func (e ArchiveChunkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StopWatchRequestValidationError) Reason() string { return e.reason }
+func (e ArchiveChunkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StopWatchRequestValidationError) Cause() error { return e.cause }
+func (e ArchiveChunkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StopWatchRequestValidationError) Key() bool { return e.key }
+func (e ArchiveChunkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StopWatchRequestValidationError) ErrorName() string { return "StopWatchRequestValidationError" }
+func (e ArchiveChunkValidationError) ErrorName() string { return "ArchiveChunkValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StopWatchRequestValidationError) Error() string {
+func (e ArchiveChunkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1475,14 +5212,14 @@ func (e StopWatchRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStopWatchRequest.%s: %s%s",
+		"invalid %sArchiveChunk.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StopWatchRequestValidationError{}
+var _ error = ArchiveChunkValidationError{}
 
 var _ interface {
 	Field() string
@@ -1490,36 +5227,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StopWatchRequestValidationError{}
+} = ArchiveChunkValidationError{}
 
-// Validate checks the field values on GetStateRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *GetStateRequest) Validate() error {
+// Validate checks the field values on ImportWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportWorkspaceRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
-		return GetStateRequestValidationError{
-			field:  "WorkspaceId",
-			reason: "value length must be at least 1 runes",
-		}
-	}
+	// no validation rules for WorkspaceId
 
-	if utf8.RuneCountInString(m.GetLayoutId()) < 1 {
-		return GetStateRequestValidationError{
-			field:  "LayoutId",
-			reason: "value length must be at least 1 runes",
-		}
-	}
+	// no validation rules for Key
+
+	// no validation rules for Data
 
 	return nil
 }
 
-// GetStateRequestValidationError is the validation error returned by
-// GetStateRequest.Validate if the designated constraints aren't met.
-type GetStateRequestValidationError struct {
+// ImportWorkspaceRequestValidationError is the validation error returned by
+// ImportWorkspaceRequest.Validate if the designated constraints aren't met.
+type ImportWorkspaceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1527,22 +5256,24 @@ type GetStateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStateRequestValidationError) Field() string { return e.field }
+func (e ImportWorkspaceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStateRequestValidationError) Reason() string { return e.reason }
+func (e ImportWorkspaceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStateRequestValidationError) Cause() error { return e.cause }
+func (e ImportWorkspaceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStateRequestValidationError) Key() bool { return e.key }
+func (e ImportWorkspaceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStateRequestValidationError) ErrorName() string { return "GetStateRequestValidationError" }
+func (e ImportWorkspaceRequestValidationError) ErrorName() string {
+	return "ImportWorkspaceRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetStateRequestValidationError) Error() string {
+func (e ImportWorkspaceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1554,14 +5285,14 @@ func (e GetStateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStateRequest.%s: %s%s",
+		"invalid %sImportWorkspaceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStateRequestValidationError{}
+var _ error = ImportWorkspaceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1569,24 +5300,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStateRequestValidationError{}
+} = ImportWorkspaceRequestValidationError{}
 
-// Validate checks the field values on GetStateResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *GetStateResponse) Validate() error {
+// Validate checks the field values on StateVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *StateVersionRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for State
+	if utf8.RuneCountInString(m.GetWorkspaceId()) < 1 {
+		return StateVersionRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLayoutId()) < 1 {
+		return StateVersionRequestValidationError{
+			field:  "LayoutId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return StateVersionRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
 
 	return nil
 }
 
-// GetStateResponseValidationError is the validation error returned by
-// GetStateResponse.Validate if the designated constraints aren't met.
-type GetStateResponseValidationError struct {
+// StateVersionRequestValidationError is the validation error returned by
+// StateVersionRequest.Validate if the designated constraints aren't met.
+type StateVersionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1594,22 +5344,24 @@ type GetStateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStateResponseValidationError) Field() string { return e.field }
+func (e StateVersionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStateResponseValidationError) Reason() string { return e.reason }
+func (e StateVersionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStateResponseValidationError) Cause() error { return e.cause }
+func (e StateVersionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStateResponseValidationError) Key() bool { return e.key }
+func (e StateVersionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStateResponseValidationError) ErrorName() string { return "GetStateResponseValidationError" }
+func (e StateVersionRequestValidationError) ErrorName() string {
+	return "StateVersionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetStateResponseValidationError) Error() string {
+func (e StateVersionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1621,14 +5373,14 @@ func (e GetStateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStateResponse.%s: %s%s",
+		"invalid %sStateVersionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStateResponseValidationError{}
+var _ error = StateVersionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1636,7 +5388,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStateResponseValidationError{}
+} = StateVersionRequestValidationError{}
 
 // Validate checks the field values on GetOutputRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, an
@@ -1660,6 +5412,8 @@ func (m *GetOutputRequest) Validate() error {
 		}
 	}
 
+	// no validation rules for Name
+
 	return nil
 }
 
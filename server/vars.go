@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// UpdateWorkspaceVars merges a patch into the latest vars of a Workspace, saving them as a
+// new version. Unlike SaveWorkspace, keys the patch does not mention are left as they are.
+func (s *Server) UpdateWorkspaceVars(ctx context.Context, in *UpdateWorkspaceVarsRequest) (*WorkspaceVarsVersion, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	patch := map[string]interface{}{}
+	if err := json.Unmarshal(in.Vars, &patch); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	tree := types.MakeTree(in.Id)
+	w := types.Workspace(in.Id)
+	if err := s.store.Get(&w, tree); err != nil {
+		return nil, errors.Wrap(err, Errors_NOT_FOUND.String())
+	}
+
+	unlock, err := s.lockVars(in.Id, "UpdateWorkspaceVars")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	vars := types.Vars{}
+	if err := s.store.Get(&vars, tree); err != nil && !strings.Contains(err.Error(), "Missing") {
+		return nil, err
+	}
+
+	// An id saved along with the vars is not the id of this version.
+	delete(vars, "id")
+
+	vars = types.Vars(mergePatch(map[string]interface{}(vars), patch).(map[string]interface{}))
+
+	if err := s.store.Save(&vars, tree); err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	for k := range patch {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	version := fmt.Sprint(vars["id"])
+	s.audit(in.Id, &types.AuditEvent{
+		Action: "UpdateWorkspaceVars",
+		Actor:  caller(ctx),
+		Detail: fmt.Sprintf("Version %v changed %v", version, strings.Join(keys, ", ")),
+	})
+
+	return &WorkspaceVarsVersion{Id: version}, nil
+}
+
+// varsLockTTL is how long the vars of a Workspace may stay locked, well past what a change
+// of them takes. An older lock was left behind by a server that died holding it.
+const varsLockTTL = time.Minute
+
+// lockVars of a Workspace for holder, the call that changes them, while they are read,
+// changed and saved, so that another change in the meantime is not lost.
+// Returns what unlocks them.
+func (s *Server) lockVars(wID, holder string) (func(), error) {
+	unlock, err := s.lockFor(path.Join(wID, types.VAR), holder, varsLockTTL, saveRetry)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%v: Vars of Workspace %v are being changed", Errors_LOCKED, wID)
+	}
+
+	return unlock, nil
+}
+
+// mergePatch applies a JSON Merge Patch, as per RFC 7396, to a value.
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	t, ok := target.(map[string]interface{})
+	if !ok || t == nil {
+		t = map[string]interface{}{}
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+
+		t[k] = mergePatch(t[k], v)
+	}
+
+	return t
+}
+
+// ListWorkspaceVarVersions of a Workspace, newest first.
+func (s *Server) ListWorkspaceVarVersions(ctx context.Context, in *GetWorkspaceRequest) (*WorkspaceVarsVersions, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	ids, err := s.store.GetVersions(&types.Vars{}, types.MakeTree(in.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &WorkspaceVarsVersions{Versions: newestFirst(ids)}, nil
+}
+
+// DiffWorkspaceVars compares two versions of the vars of a Workspace, by default the
+// latest with the one before it. Values are only returned with secrets redacted.
+func (s *Server) DiffWorkspaceVars(ctx context.Context, in *DiffWorkspaceVarsRequest) (*WorkspaceVarsDiff, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	tree := types.MakeTree(in.Id)
+	from, to := in.From, in.To
+
+	if from == "" || to == "" {
+		versions, err := s.store.GetVersions(&types.Vars{}, tree)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := newestFirst(versions)
+		if to == "" {
+			if len(ids) == 0 {
+				return nil, errors.Errorf("%v: Workspace %v has no vars", Errors_NOT_FOUND, in.Id)
+			}
+
+			to = ids[0]
+		}
+
+		if from == "" {
+			for _, id := range ids {
+				if olderThan(id, to) {
+					from = id
+					break
+				}
+			}
+		}
+	}
+
+	toVars, err := s.varsVersion(in.Id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromVars := types.Vars{}
+	if from != "" {
+		if fromVars, err = s.varsVersion(in.Id, from); err != nil {
+			return nil, err
+		}
+	}
+
+	paths := []string{}
+	diffValue("", map[string]interface{}(fromVars), map[string]interface{}(toVars), &paths)
+
+	fromVars.RedactSecrets(s.redactor)
+	toVars.RedactSecrets(s.redactor)
+	fb, _ := fromVars.Marshal()
+	tb, _ := toVars.Marshal()
+
+	return &WorkspaceVarsDiff{From: from, To: to, Paths: paths, FromVars: fb, ToVars: tb}, nil
+}
+
+// RestoreWorkspaceVars saves an earlier version of the vars of a Workspace as the latest.
+func (s *Server) RestoreWorkspaceVars(ctx context.Context, in *RestoreWorkspaceVarsRequest) (*WorkspaceVarsVersion, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, Errors_INVALID_VALUE.String())
+	}
+
+	unlock, err := s.lockVars(in.Id, "RestoreWorkspaceVars")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	vars, err := s.varsVersion(in.Id, in.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Save(&vars, types.MakeTree(in.Id)); err != nil {
+		return nil, err
+	}
+
+	version := fmt.Sprint(vars["id"])
+	s.audit(in.Id, &types.AuditEvent{
+		Action: "RestoreWorkspaceVars",
+		Actor:  caller(ctx),
+		Detail: fmt.Sprintf("Version %v restored from %v", version, in.Version),
+	})
+
+	return &WorkspaceVarsVersion{Id: version}, nil
+}
+
+// varsVersion returns a version of the vars of a Workspace.
+func (s *Server) varsVersion(wID, version string) (types.Vars, error) {
+	vars := types.Vars{}
+	if err := s.store.GetVersion(&vars, types.MakeTree(wID), version); err != nil {
+		return nil, errors.Wrap(err, Errors_NOT_FOUND.String())
+	}
+
+	// An id saved along with the vars is not the id of this version.
+	delete(vars, "id")
+	return vars, nil
+}
@@ -0,0 +1,456 @@
+// Package archive exports a Workspace, with everything saved under it, to a tar.gz and
+// imports it back into any Storer, under the same or another name.
+//
+// The archive holds a manifest, followed by a file per key. Keys under the Workspace are
+// kept under workspace/ and the state of its Layouts under state/, so that they can be
+// restored under any name. Vars, state and the Plans of Jobs are either encrypted with a
+// key of the archive's own, or redacted, in which case the archive cannot be imported.
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/redact"
+	"github.com/tsocial/tessellate/storage"
+	"github.com/tsocial/tessellate/storage/envelope"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+const (
+	manifestName = "manifest.json"
+	workspaceDir = "workspace"
+)
+
+// Manifest describes what an archive holds.
+type Manifest struct {
+	Workspace  string `json:"workspace"`
+	Encrypted  bool   `json:"encrypted"`
+	ExportedAt int64  `json:"exported_at"`
+}
+
+// node stands in for a ReaderWriter, to list the keys right under a path.
+type node string
+
+func (n node) MakePath(*types.Tree) string { return string(n) }
+func (n node) Marshal() ([]byte, error)    { return nil, nil }
+func (n node) Unmarshal([]byte) error      { return nil }
+func (n node) SaveId(string)               {}
+
+// roots of the keys of a Workspace in a Storer, along with where they go in an archive.
+func roots(wID string) [][2]string {
+	return [][2]string{
+		{path.Join(types.WORKSPACE, wID), workspaceDir},
+		{path.Join(types.STATE, wID), types.STATE},
+		{path.Join(types.AUDIT, wID), types.AUDIT},
+	}
+}
+
+// versioned returns what the versions under dir are read and saved as, along with their
+// tree, if they are Vars or state snapshots. These go through Get and Save of the Storer,
+// which decrypt and encrypt them, rather than as they are stored.
+func versioned(dir string) (types.ReaderWriter, *types.Tree) {
+	parts := strings.Split(dir, "/")
+	if len(parts) < 3 || parts[0] != types.WORKSPACE {
+		return nil, nil
+	}
+
+	switch {
+	case len(parts) == 3 && parts[2] == types.VAR:
+		return &types.Vars{}, types.MakeTree(parts[1])
+
+	case len(parts) == 5 && parts[2] == types.LAYOUT && parts[4] == types.VAR:
+		return &types.Vars{}, types.MakeTree(parts[1], parts[3])
+
+	case len(parts) == 5 && parts[2] == types.LAYOUT && parts[4] == types.SNAPSHOT:
+		return &types.StateVersion{}, types.MakeTree(parts[1], parts[3])
+	}
+
+	return nil, nil
+}
+
+func isVersion(v string) bool {
+	_, err := strconv.ParseInt(v, 10, 64)
+	return err == nil
+}
+
+// queueDir tells if dir holds the queue of a Layout.
+func queueDir(dir string) bool {
+	parts := strings.Split(dir, "/")
+	return len(parts) == 5 && parts[0] == types.WORKSPACE && parts[2] == types.LAYOUT &&
+		parts[4] == types.QUEUE
+}
+
+// settle a Job, or a StackRun, that had yet to finish when it was exported, by marking it
+// ABORTED. Nothing runs it where it is imported, and the scheduler there must not pick it
+// up. Everything else is returned as it is.
+func settle(key string, b []byte) ([]byte, error) {
+	parts := strings.Split(key, "/")
+	if parts[0] != types.WORKSPACE {
+		return b, nil
+	}
+
+	n := len(parts)
+	switch {
+	// jobs/<layout>/<id>, or jobs/<layout>/<id>/history/<version>
+	case parts[2] == types.JOB && (n == 5 || n == 7 && parts[5] == types.HISTORY):
+		j := types.Job{}
+		if err := j.Unmarshal(b); err != nil {
+			return nil, errors.Wrapf(err, "Cannot read %v", key)
+		}
+
+		if finished(j.Status) {
+			return b, nil
+		}
+
+		j.Status = types.JobAborted
+		j.Error = "Aborted on import"
+		return j.Marshal()
+
+	// stacks/<stack>/runs/<id>, or stacks/<stack>/runs/<id>/history/<version>
+	case parts[2] == types.STACK && n >= 6 && parts[4] == types.RUN &&
+		(n == 6 || n == 8 && parts[6] == types.HISTORY):
+		r := types.StackRun{}
+		if err := r.Unmarshal(b); err != nil {
+			return nil, errors.Wrapf(err, "Cannot read %v", key)
+		}
+
+		if finished(r.Status) {
+			return b, nil
+		}
+
+		r.Status = types.JobAborted
+		r.Error = "Aborted on import"
+		return r.Marshal()
+	}
+
+	return b, nil
+}
+
+func finished(status int32) bool {
+	switch status {
+	case types.JobDone, types.JobFailed, types.JobAborted, types.JobError:
+		return true
+	}
+
+	return false
+}
+
+// Export every key of a Workspace to w, as a tar.gz.
+// Vars and state are encrypted with keys, or redacted by r if there are no keys.
+// Locks and queues are left out, they only mean something to the installation that
+// holds them.
+func Export(s storage.Storer, wID string, w io.Writer, keys *envelope.Keyring, r *redact.Redactor) error {
+	gz := gzip.NewWriter(w)
+	e := &exporter{
+		store: s,
+		keys:  keys,
+		r:     r,
+		tw:    tar.NewWriter(gz),
+		now:   time.Now(),
+	}
+
+	m := Manifest{Workspace: wID, Encrypted: keys != nil, ExportedAt: e.now.Unix()}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	if err := e.write(manifestName, b); err != nil {
+		return err
+	}
+
+	for _, root := range roots(wID) {
+		if err := e.walk(root[0], root[1]); err != nil {
+			return err
+		}
+	}
+
+	if err := e.tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+type exporter struct {
+	store storage.Storer
+	keys  *envelope.Keyring
+	r     *redact.Redactor
+	tw    *tar.Writer
+	now   time.Time
+}
+
+func (e *exporter) write(name string, b []byte) error {
+	h := &tar.Header{Name: name, Mode: 0600, Size: int64(len(b)), ModTime: e.now}
+	if err := e.tw.WriteHeader(h); err != nil {
+		return err
+	}
+
+	_, err := e.tw.Write(b)
+	return err
+}
+
+// walk the keys under dir, depth first, saving them under name.
+func (e *exporter) walk(dir, name string) error {
+	leaves, err := e.store.GetVersions(node(dir), nil)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot list %v", dir)
+	}
+	sort.Strings(leaves)
+
+	for _, l := range leaves {
+		if l == types.LOCK {
+			continue
+		}
+
+		if err := e.export(dir, l, path.Join(name, l)); err != nil {
+			return err
+		}
+	}
+
+	keys, err := e.store.GetKeys(dir+"/", "/")
+	if err != nil {
+		return errors.Wrapf(err, "Cannot list %v", dir)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if !strings.HasSuffix(k, "/") {
+			continue
+		}
+
+		child := path.Base(k)
+		if child == types.LOCK || queueDir(path.Join(dir, child)) {
+			continue
+		}
+
+		if err := e.walk(path.Join(dir, child), path.Join(name, child)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) export(dir, leaf, name string) error {
+	key := path.Join(dir, leaf)
+	secret := envelope.SecretKey(key)
+
+	var b []byte
+	if rw, tree := versioned(dir); rw != nil {
+		// Latest is saved again along with the newest version, on import.
+		if !isVersion(leaf) {
+			return nil
+		}
+
+		if err := e.store.GetVersion(rw, tree, leaf); err != nil {
+			return errors.Wrapf(err, "Cannot get %v", key)
+		}
+
+		if e.keys == nil {
+			e.redact(rw)
+		}
+
+		var err error
+		if b, err = rw.Marshal(); err != nil {
+			return err
+		}
+
+		secret = true
+	} else {
+		var err error
+		if b, err = e.store.GetKey(key); err != nil {
+			return errors.Wrapf(err, "Cannot get %v", key)
+		}
+
+		if secret && e.keys == nil {
+			// A binary Plan cannot be redacted, so it is left out.
+			if path.Base(key) == types.PLANFILE {
+				return nil
+			}
+
+			b = redactJSON(e.r, b)
+		}
+	}
+
+	if secret && e.keys != nil {
+		sb, err := e.keys.Seal(b)
+		if err != nil {
+			return errors.Wrapf(err, "Cannot encrypt %v", key)
+		}
+
+		b = sb
+	}
+
+	return e.write(name, b)
+}
+
+func (e *exporter) redact(rw types.ReaderWriter) {
+	switch v := rw.(type) {
+	case *types.Vars:
+		v.RedactSecrets(e.r)
+	case *types.StateVersion:
+		v.State = redactJSON(e.r, v.State)
+	}
+}
+
+// redactJSON masks the secrets in a JSON document, or in text if it is not one.
+func redactJSON(r *redact.Redactor, b []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return r.Text(b)
+	}
+
+	out, err := json.Marshal(r.Value(v))
+	if err != nil {
+		return r.Text(b)
+	}
+
+	return out
+}
+
+// Reader of an archive made by Export.
+type Reader struct {
+	Manifest Manifest
+	tr       *tar.Reader
+}
+
+// NewReader reads the manifest of an archive, the rest is read by Import.
+func NewReader(r io.Reader) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot read archive")
+	}
+
+	tr := tar.NewReader(gz)
+	h, err := tr.Next()
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot read archive")
+	}
+
+	if h.Name != manifestName {
+		return nil, errors.Errorf("Archive must begin with %v, found %v", manifestName, h.Name)
+	}
+
+	ar := &Reader{tr: tr}
+	if err := json.NewDecoder(tr).Decode(&ar.Manifest); err != nil {
+		return nil, errors.Wrap(err, "Cannot read manifest")
+	}
+
+	return ar, nil
+}
+
+// Import the archive into a Storer, as the Workspace wID, decrypting its vars and state
+// with keys. Versions keep the IDs they had, and vars and state are saved through the
+// Storer, to be encrypted the way it does. Jobs and Stack runs that had not finished are
+// imported as ABORTED. Whatever the Workspace already has under the same keys is
+// overwritten. Returns the number of keys imported.
+func (ar *Reader) Import(s storage.Storer, wID string, keys *envelope.Keyring) (int, error) {
+	if !ar.Manifest.Encrypted {
+		return 0, errors.New("Archive has its secrets redacted, it cannot be imported")
+	}
+
+	if keys == nil {
+		return 0, errors.New("Archive is encrypted, a key is needed to import it")
+	}
+
+	wKey, err := json.Marshal(wID)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for {
+		h, err := ar.tr.Next()
+		if err == io.EOF {
+			return n, nil
+		}
+
+		if err != nil {
+			return n, errors.Wrap(err, "Cannot read archive")
+		}
+
+		if h.Typeflag != tar.TypeReg && h.Typeflag != tar.TypeRegA {
+			continue
+		}
+
+		key, err := storeKey(wID, h.Name)
+		if err != nil {
+			return n, err
+		}
+
+		b, err := ioutil.ReadAll(ar.tr)
+		if err != nil {
+			return n, errors.Wrapf(err, "Cannot read %v", h.Name)
+		}
+
+		dir, leaf := path.Split(key)
+		dir = strings.TrimSuffix(dir, "/")
+
+		if rw, tree := versioned(dir); rw != nil {
+			if !isVersion(leaf) {
+				continue
+			}
+
+			plain, err := keys.Open(b)
+			if err != nil {
+				return n, errors.Wrapf(err, "Cannot decrypt %v", h.Name)
+			}
+
+			if err := rw.Unmarshal(plain); err != nil {
+				return n, errors.Wrapf(err, "Cannot read %v", h.Name)
+			}
+
+			if err := s.SaveTag(rw, tree, leaf); err != nil {
+				return n, errors.Wrapf(err, "Cannot save %v", key)
+			}
+
+			n++
+			continue
+		}
+
+		switch {
+		case envelope.SecretKey(key):
+			if b, err = keys.Open(b); err != nil {
+				return n, errors.Wrapf(err, "Cannot decrypt %v", h.Name)
+			}
+
+		case dir == path.Join(types.WORKSPACE, wID):
+			// The Workspace itself, which is saved as its name.
+			b = wKey
+
+		default:
+			if b, err = settle(key, b); err != nil {
+				return n, err
+			}
+		}
+
+		if err := s.SaveKey(key, b); err != nil {
+			return n, errors.Wrapf(err, "Cannot save %v", key)
+		}
+
+		n++
+	}
+}
+
+// storeKey is the key that a file of an archive is saved as, in the Workspace wID.
+func storeKey(wID, name string) (string, error) {
+	name = path.Clean(name)
+	for _, root := range roots(wID) {
+		if strings.HasPrefix(name, root[1]+"/") {
+			return path.Join(root[0], strings.TrimPrefix(name, root[1]+"/")), nil
+		}
+	}
+
+	return "", errors.Errorf("Unexpected file %v in archive", name)
+}
@@ -0,0 +1,197 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsocial/tessellate/redact"
+	"github.com/tsocial/tessellate/storage/envelope"
+	"github.com/tsocial/tessellate/storage/memory"
+	"github.com/tsocial/tessellate/storage/types"
+	"github.com/tsocial/tessellate/utils"
+)
+
+func newKeyring(t *testing.T) *envelope.Keyring {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	assert.Nil(t, err)
+
+	r, err := envelope.NewKeyring(base64.StdEncoding.EncodeToString(b))
+	assert.Nil(t, err)
+	return r
+}
+
+func newStore(t *testing.T) *memory.BoltStore {
+	bucket := utils.RandString(8)
+	s := memory.MakeBoltStore(bucket, "/tmp/"+bucket)
+	assert.Nil(t, s.Setup())
+	return s
+}
+
+// contents of an archive, uncompressed.
+func contents(t *testing.T, b []byte) string {
+	gz, err := gzip.NewReader(bytes.NewReader(b))
+	assert.Nil(t, err)
+
+	out, err := ioutil.ReadAll(gz)
+	assert.Nil(t, err)
+	return string(out)
+}
+
+func TestArchive(t *testing.T) {
+	source := envelope.Wrap(newStore(t), newKeyring(t))
+	source.EncryptState()
+
+	wID, lID := "staging", "network"
+	wTree, lTree := types.MakeTree(wID), types.MakeTree(wID, lID)
+
+	w := types.Workspace(wID)
+	assert.Nil(t, source.Save(&w, wTree))
+
+	vars := types.Vars{"aws": map[string]interface{}{"secret_key": "s3cr3t"}}
+	assert.Nil(t, source.Save(&vars, wTree))
+
+	layout := types.Layout{Id: lID, Plan: map[string]json.RawMessage{"main.tf.json": json.RawMessage(`{}`)}}
+	assert.Nil(t, source.Save(&layout, wTree))
+	assert.Nil(t, source.Save(&layout, wTree))
+
+	job := types.Job{LayoutId: lID, LayoutVersion: layout.Version}
+	assert.Nil(t, source.Save(&job, wTree))
+	assert.Nil(t, source.SaveKey(job.LogKey(wTree, 0), []byte("Apply complete!\n")))
+	plan := []byte(`{"planned_values": {"password": "s3cr3t"}}`)
+	assert.Nil(t, source.SaveKey(job.PlanKey(wTree), plan))
+	planFile := []byte("PK\x03\x04 s3cr3t")
+	assert.Nil(t, source.SaveKey(job.PlanFileKey(wTree), planFile))
+
+	state := []byte(`{"version": 4, "password": "s3cr3t"}`)
+	stateKey := path.Join(types.STATE, wID, lID)
+	assert.Nil(t, source.SaveKey(stateKey, state))
+
+	snapshot := types.StateVersion{State: state, Serial: 3}
+	assert.Nil(t, source.Save(&snapshot, lTree))
+
+	assert.Nil(t, source.Save(&types.LockInfo{Holder: "job"}, lTree))
+
+	done := types.Job{LayoutId: lID, Status: types.JobDone}
+	assert.Nil(t, source.Save(&done, wTree))
+
+	q := types.QueuedJob{Id: job.Id}
+	assert.Nil(t, source.SaveKey(q.Key(lTree), []byte(`{}`)))
+
+	run := types.StackRun{StackId: "env", Status: types.JobRunning}
+	assert.Nil(t, source.Save(&run, wTree))
+
+	keys := newKeyring(t)
+
+	t.Run("Should import an encrypted archive under another name", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		assert.Nil(t, Export(source, wID, buf, keys, nil))
+		c := contents(t, buf.Bytes())
+		assert.Contains(t, c, "Apply complete!")
+		assert.NotContains(t, c, "s3cr3t")
+		assert.NotContains(t, c, "/lock/")
+		assert.NotContains(t, c, "/"+types.QUEUE+"/")
+
+		ar, err := NewReader(buf)
+		assert.Nil(t, err)
+		assert.Equal(t, wID, ar.Manifest.Workspace)
+		assert.True(t, ar.Manifest.Encrypted)
+
+		target := newStore(t)
+		n, err := ar.Import(target, "prod", keys)
+		assert.Nil(t, err)
+		assert.NotZero(t, n)
+
+		pTree := types.MakeTree("prod")
+
+		b, err := target.GetKey(path.Join(types.WORKSPACE, "prod", "latest"))
+		assert.Nil(t, err)
+		assert.Equal(t, `"prod"`, string(b))
+
+		v := types.Vars{}
+		assert.Nil(t, target.Get(&v, pTree))
+		assert.Equal(t, "s3cr3t", v["aws"].(map[string]interface{})["secret_key"])
+
+		l := types.Layout{Id: lID}
+		assert.Nil(t, target.GetVersion(&l, pTree, layout.Version))
+
+		versions, err := target.GetVersions(&types.Layout{Id: lID}, pTree)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(versions))
+
+		j := types.Job{LayoutId: lID}
+		assert.Nil(t, target.GetVersion(&j, pTree, job.Id))
+		assert.Equal(t, layout.Version, j.LayoutVersion)
+		assert.Equal(t, types.JobAborted, j.Status)
+
+		j = types.Job{LayoutId: lID}
+		assert.Nil(t, target.GetVersion(&j, pTree, done.Id))
+		assert.Equal(t, types.JobDone, j.Status)
+
+		r := types.StackRun{StackId: "env"}
+		assert.Nil(t, target.GetVersion(&r, pTree, run.Id))
+		assert.Equal(t, types.JobAborted, r.Status)
+
+		b, err = target.GetKey(job.LogKey(pTree, 0))
+		assert.Nil(t, err)
+		assert.Equal(t, "Apply complete!\n", string(b))
+
+		b, err = target.GetKey(job.PlanKey(pTree))
+		assert.Nil(t, err)
+		assert.Equal(t, plan, b)
+
+		b, err = target.GetKey(job.PlanFileKey(pTree))
+		assert.Nil(t, err)
+		assert.Equal(t, planFile, b)
+
+		b, err = target.GetKey(path.Join(types.STATE, "prod", lID))
+		assert.Nil(t, err)
+		assert.Equal(t, state, b)
+
+		sv := types.StateVersion{}
+		assert.Nil(t, target.GetVersion(&sv, types.MakeTree("prod", lID), snapshot.Id))
+		assert.Equal(t, state, sv.State)
+
+		assert.NotNil(t, target.Get(&types.LockInfo{}, types.MakeTree("prod", lID)))
+	})
+
+	t.Run("Should not decrypt an archive with another key", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		assert.Nil(t, Export(source, wID, buf, keys, nil))
+
+		ar, err := NewReader(buf)
+		assert.Nil(t, err)
+
+		_, err = ar.Import(newStore(t), wID, newKeyring(t))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should redact secrets without a key, and not import them", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		assert.Nil(t, Export(source, wID, buf, nil, redact.Default()))
+		c := contents(t, buf.Bytes())
+		assert.Contains(t, c, "secret_key")
+		assert.NotContains(t, c, "s3cr3t")
+		assert.Contains(t, c, "/"+types.PLAN)
+		assert.NotContains(t, c, "/"+types.PLANFILE)
+
+		ar, err := NewReader(buf)
+		assert.Nil(t, err)
+		assert.False(t, ar.Manifest.Encrypted)
+
+		_, err = ar.Import(newStore(t), wID, keys)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should not import what is not an archive", func(t *testing.T) {
+		_, err := NewReader(bytes.NewBufferString("{}"))
+		assert.NotNil(t, err)
+	})
+}
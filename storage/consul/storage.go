@@ -261,10 +261,59 @@ func (e *ConsulStore) Unlock(key string) error {
 	return nil
 }
 
+// UnlockIf unlocks the key only if it is still locked with s, so that a lock
+// taken by someone else in the meantime is left alone.
+func (e *ConsulStore) UnlockIf(key, s string) error {
+	pair, _, err := e.client.KV().Get(path.Join("lock", key), nil)
+	if err != nil {
+		return err
+	}
+
+	if pair == nil {
+		return nil
+	}
+
+	if string(pair.Value) != s {
+		return errors.Errorf("Key %v is locked by %v", key, string(pair.Value))
+	}
+
+	ok, _, err := e.client.KV().DeleteCAS(pair, nil)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errors.Errorf("Key %v was locked again", key)
+	}
+
+	return nil
+}
+
+// GetLock returns the value a key was locked with, empty if it isn't locked.
+func (e *ConsulStore) GetLock(key string) (string, error) {
+	b, _, err := e.client.KV().Get(path.Join("lock", key), nil)
+	if err != nil {
+		return "", err
+	}
+
+	if b == nil {
+		return "", nil
+	}
+
+	return string(b.Value), nil
+}
+
 func (e *ConsulStore) Teardown() error {
 	return nil
 }
 
+// DeleteKey deletes a single Key.
+// Does not raise error if the Key is absent.
+func (e *ConsulStore) DeleteKey(key string) error {
+	_, err := e.client.KV().Delete(key, nil)
+	return err
+}
+
 func (e *ConsulStore) DeleteKeys(prefix string) error {
 	_, err := e.client.KV().DeleteTree(prefix+"/", &api.WriteOptions{})
 	return err
@@ -0,0 +1,245 @@
+package envelope
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsocial/tessellate/storage/memory"
+	"github.com/tsocial/tessellate/storage/types"
+	"github.com/tsocial/tessellate/utils"
+)
+
+func newKey(t *testing.T) string {
+	b := make([]byte, keySize)
+	_, err := rand.Read(b)
+	assert.Nil(t, err)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestKeyring(t *testing.T) {
+	k1, k2 := newKey(t), newKey(t)
+
+	r, err := NewKeyring(k1)
+	assert.Nil(t, err)
+
+	t.Run("Should open what it sealed", func(t *testing.T) {
+		b, err := r.Seal([]byte(`{"secret_key": "s3cr3t"}`))
+		assert.Nil(t, err)
+		assert.NotContains(t, string(b), "s3cr3t")
+		assert.True(t, r.Current(b))
+
+		out, err := r.Open(b)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"secret_key": "s3cr3t"}`, string(out))
+	})
+
+	t.Run("Should pass through values that are not sealed", func(t *testing.T) {
+		out, err := r.Open([]byte(`{"a": 1}`))
+		assert.Nil(t, err)
+		assert.Equal(t, `{"a": 1}`, string(out))
+	})
+
+	t.Run("Should not open a value sealed with an unknown key", func(t *testing.T) {
+		other, err := NewKeyring(k2)
+		assert.Nil(t, err)
+
+		b, err := other.Seal([]byte(`{}`))
+		assert.Nil(t, err)
+
+		_, err = r.Open(b)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should not accept a short key", func(t *testing.T) {
+		_, err := NewKeyring(base64.StdEncoding.EncodeToString([]byte("short")))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should have no keyring without keys", func(t *testing.T) {
+		r, err := LoadKeyring("", " ,")
+		assert.Nil(t, err)
+		assert.Nil(t, r)
+	})
+}
+
+func TestEnvelopeStore(t *testing.T) {
+	bucket := utils.RandString(8)
+	bolt := memory.MakeBoltStore(bucket, "/tmp/"+bucket)
+	assert.Nil(t, bolt.Setup())
+	defer bolt.Teardown()
+
+	oldKey, newKey := newKey(t), newKey(t)
+	r, err := NewKeyring(oldKey)
+	assert.Nil(t, err)
+
+	store := Wrap(bolt, r)
+	tree := types.MakeTree("workspace", "layout")
+
+	vars := types.Vars{"aws": map[string]interface{}{"secret_key": "s3cr3t"}}
+	assert.Nil(t, store.Save(&vars, tree))
+	version := vars["id"].(string)
+
+	t.Run("Should save Vars encrypted", func(t *testing.T) {
+		for _, v := range []string{"latest", version} {
+			b, err := bolt.GetKey(path.Join(vars.MakePath(tree), v))
+			assert.Nil(t, err)
+			assert.NotEmpty(t, b)
+			assert.NotContains(t, string(b), "s3cr3t")
+		}
+	})
+
+	t.Run("Should read Vars decrypted", func(t *testing.T) {
+		v := types.Vars{}
+		assert.Nil(t, store.Get(&v, tree))
+		assert.Equal(t, "s3cr3t", v["aws"].(map[string]interface{})["secret_key"])
+
+		v = types.Vars{}
+		assert.Nil(t, store.GetVersion(&v, tree, version))
+		assert.Equal(t, "s3cr3t", v["aws"].(map[string]interface{})["secret_key"])
+	})
+
+	t.Run("Should leave everything else as it is", func(t *testing.T) {
+		w := types.Watch{SuccessURL: "http://s3cr3t"}
+		assert.Nil(t, store.Save(&w, tree))
+
+		b, err := bolt.GetKey(path.Join(w.MakePath(tree), "latest"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(b), "s3cr3t")
+	})
+
+	t.Run("Should rotate every version to the new key", func(t *testing.T) {
+		plain := types.Vars{"team": "plain"}
+		assert.Nil(t, bolt.Save(&plain, tree))
+
+		rotated, err := NewKeyring(newKey, oldKey)
+		assert.Nil(t, err)
+
+		store = Wrap(bolt, rotated)
+		n, err := store.RotateVars(tree)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, n)
+
+		n, err = store.RotateVars(tree)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+
+		newOnly, err := NewKeyring(newKey)
+		assert.Nil(t, err)
+
+		v := types.Vars{}
+		assert.Nil(t, Wrap(bolt, newOnly).GetVersion(&v, tree, version))
+		assert.Equal(t, "s3cr3t", v["aws"].(map[string]interface{})["secret_key"])
+
+		v = types.Vars{}
+		assert.Nil(t, Wrap(bolt, newOnly).Get(&v, tree))
+		assert.Equal(t, "plain", v["team"])
+	})
+}
+
+func TestEnvelopeStore_State(t *testing.T) {
+	bucket := utils.RandString(8)
+	bolt := memory.MakeBoltStore(bucket, "/tmp/"+bucket)
+	assert.Nil(t, bolt.Setup())
+
+	k := newKey(t)
+	r, err := NewKeyring(k)
+	assert.Nil(t, err)
+
+	key := path.Join(types.STATE, "workspace", "layout")
+	tree := types.MakeTree("workspace", "layout")
+	state := []byte(`{"version": 4, "password": "s3cr3t"}`)
+
+	t.Run("Should leave the state as it is, unless asked to encrypt it", func(t *testing.T) {
+		store := Wrap(bolt, r)
+		assert.Nil(t, store.SaveKey(key, state))
+
+		b, err := bolt.GetKey(key)
+		assert.Nil(t, err)
+		assert.Equal(t, state, b)
+
+		n, err := store.RotateState("workspace", "layout")
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	store := Wrap(bolt, r)
+	store.EncryptState()
+
+	t.Run("Should encrypt the state and its snapshots", func(t *testing.T) {
+		assert.Nil(t, store.SaveKey(key, state))
+
+		v := types.StateVersion{State: state}
+		assert.Nil(t, store.Save(&v, tree))
+
+		b, err := bolt.GetKey(key)
+		assert.Nil(t, err)
+		assert.NotContains(t, string(b), "s3cr3t")
+
+		b, err = bolt.GetKey(path.Join(v.MakePath(tree), v.Id))
+		assert.Nil(t, err)
+		assert.NotContains(t, string(b), "s3cr3t")
+	})
+
+	t.Run("Should encrypt the Plans of Jobs", func(t *testing.T) {
+		j := types.Job{LayoutId: "layout", Id: "1"}
+		wTree := types.MakeTree("workspace")
+		plan := []byte(`{"planned_values": {"password": "s3cr3t"}}`)
+
+		for _, k := range []string{j.PlanKey(wTree), j.PlanFileKey(wTree)} {
+			assert.Nil(t, store.SaveKey(k, plan))
+
+			b, err := bolt.GetKey(k)
+			assert.Nil(t, err)
+			assert.NotContains(t, string(b), "s3cr3t")
+
+			b, err = store.GetKey(k)
+			assert.Nil(t, err)
+			assert.Equal(t, plan, b)
+		}
+
+		assert.Nil(t, store.SaveKey(j.LogKey(wTree, 0), plan))
+		b, err := bolt.GetKey(j.LogKey(wTree, 0))
+		assert.Nil(t, err)
+		assert.Equal(t, plan, b)
+	})
+
+	t.Run("Should read the state decrypted, even if no longer asked to encrypt it", func(t *testing.T) {
+		b, err := Wrap(bolt, r).GetKey(key)
+		assert.Nil(t, err)
+		assert.Equal(t, state, b)
+
+		v := types.StateVersion{}
+		assert.Nil(t, Wrap(bolt, r).Get(&v, tree))
+		assert.Equal(t, state, v.State)
+	})
+
+	t.Run("Should rotate the state and its snapshots", func(t *testing.T) {
+		rotated, err := NewKeyring(newKey(t), k)
+		assert.Nil(t, err)
+
+		n, err := Wrap(bolt, rotated).RotateState("workspace", "layout")
+		assert.Nil(t, err)
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("Should rotate the Plans of Jobs", func(t *testing.T) {
+		old, err := NewKeyring(k)
+		assert.Nil(t, err)
+
+		rotated, err := NewKeyring(newKey(t), k)
+		assert.Nil(t, err)
+
+		n, err := Wrap(bolt, rotated).RotatePlans("workspace")
+		assert.Nil(t, err)
+		assert.Equal(t, 2, n)
+
+		j := types.Job{LayoutId: "layout", Id: "1"}
+		b, err := bolt.GetKey(j.PlanFileKey(types.MakeTree("workspace")))
+		assert.Nil(t, err)
+		assert.True(t, rotated.Current(b))
+		assert.False(t, old.Current(b))
+	})
+}
@@ -0,0 +1,201 @@
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	keySize = 32
+	version = 1
+)
+
+// Key encrypts the data keys of values, never the values themselves.
+type Key struct {
+	Id  string
+	aes []byte
+}
+
+// Keyring holds the key that encrypts new values, along with the keys that values
+// were encrypted with before a rotation, to decrypt them with.
+type Keyring struct {
+	primary *Key
+	keys    map[string]*Key
+}
+
+// NewKeyring out of base64 encoded 256 bit keys. The first one encrypts new values.
+func NewKeyring(encoded ...string) (*Keyring, error) {
+	r := &Keyring{keys: map[string]*Key{}}
+	for _, e := range encoded {
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(e))
+		if err != nil {
+			return nil, errors.Wrap(err, "Cannot decode key")
+		}
+
+		if len(b) != keySize {
+			return nil, errors.Errorf("Key must be %d bytes, got %d", keySize, len(b))
+		}
+
+		sum := sha256.Sum256(b)
+		k := &Key{Id: hex.EncodeToString(sum[:8]), aes: b}
+		if r.primary == nil {
+			r.primary = k
+		}
+
+		r.keys[k.Id] = k
+	}
+
+	if r.primary == nil {
+		return nil, errors.New("No key-encryption key given")
+	}
+
+	return r, nil
+}
+
+// LoadKeyring from a file, one key per line, and a comma separated list of keys.
+// Keys in the file come first. Returns nil if neither has any key, as there is
+// nothing to encrypt with.
+func LoadKeyring(file, env string) (*Keyring, error) {
+	encoded := []string{}
+	if file != "" {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "Cannot read key file")
+		}
+
+		encoded = append(encoded, strings.Split(string(b), "\n")...)
+	}
+
+	encoded = append(encoded, strings.Split(env, ",")...)
+
+	keys := []string{}
+	for _, e := range encoded {
+		if strings.TrimSpace(e) != "" {
+			keys = append(keys, e)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	return NewKeyring(keys...)
+}
+
+// envelope of an encrypted value, stored in place of the value.
+// The data key is encrypted with the Key it names, the data with the data key.
+// Both carry their nonce up front.
+type envelope struct {
+	Version int    `json:"tsl8_envelope"`
+	KeyId   string `json:"key_id"`
+	DataKey []byte `json:"data_key"`
+	Data    []byte `json:"data"`
+}
+
+// Seal a value with a new data key, encrypted with the primary key.
+func (r *Keyring) Seal(b []byte) ([]byte, error) {
+	dk := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, dk); err != nil {
+		return nil, errors.Wrap(err, "Cannot make data key")
+	}
+
+	data, err := encrypt(dk, b)
+	if err != nil {
+		return nil, err
+	}
+
+	edk, err := encrypt(r.primary.aes, dk)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&envelope{Version: version, KeyId: r.primary.Id, DataKey: edk, Data: data})
+}
+
+// Open a sealed value. Values that were stored before encryption was turned on are
+// returned as they are.
+func (r *Keyring) Open(b []byte) ([]byte, error) {
+	e, ok := parse(b)
+	if !ok {
+		return b, nil
+	}
+
+	k, ok := r.keys[e.KeyId]
+	if !ok {
+		return nil, errors.Errorf("Value was encrypted with an unknown key %v", e.KeyId)
+	}
+
+	dk, err := decrypt(k.aes, e.DataKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot decrypt data key")
+	}
+
+	data, err := decrypt(dk, e.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot decrypt value")
+	}
+
+	return data, nil
+}
+
+// Current tells if a value is sealed with the primary key, so it need not be rotated.
+func (r *Keyring) Current(b []byte) bool {
+	e, ok := parse(b)
+	return ok && e.KeyId == r.primary.Id
+}
+
+func parse(b []byte) (*envelope, bool) {
+	e := envelope{}
+	if err := json.Unmarshal(b, &e); err != nil || e.Version != version || len(e.Data) == 0 {
+		return nil, false
+	}
+
+	return &e, true
+}
+
+func encrypt(key, b []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "Cannot make nonce")
+	}
+
+	return gcm.Seal(nonce, nonce, b, nil), nil
+}
+
+func decrypt(key, b []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < gcm.NonceSize() {
+		return nil, errors.New("Ciphertext is too short")
+	}
+
+	n := gcm.NonceSize()
+	return gcm.Open(nil, b[:n], b[n:], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
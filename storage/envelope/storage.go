@@ -0,0 +1,306 @@
+package envelope
+
+import (
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// Wrap a Storer so that Vars are encrypted before they are saved, and decrypted when read.
+// Everything else is passed through as it is, unless EncryptState is turned on.
+func Wrap(s storage.Storer, keys *Keyring) *EnvelopeStore {
+	return &EnvelopeStore{Storer: s, keys: keys}
+}
+
+// EnvelopeStore encrypts every version of Vars with its own data key.
+type EnvelopeStore struct {
+	storage.Storer
+	keys  *Keyring
+	state bool
+}
+
+// EncryptState of Layouts, its snapshots and the Plans made from it, as well.
+// Terraform cannot read an encrypted state from Consul on its own, so workers must
+// serve it the state through Storer instead.
+// Encrypted state is decrypted when read even if this is off, but written as it is.
+func (e *EnvelopeStore) EncryptState() {
+	e.state = true
+}
+
+// sealed stands in for a ReaderWriter, to save and read its encrypted bytes.
+type sealed struct {
+	types.ReaderWriter
+	b []byte
+}
+
+func (s *sealed) Marshal() ([]byte, error) {
+	return s.b, nil
+}
+
+func (s *sealed) Unmarshal(b []byte) error {
+	s.b = b
+	return nil
+}
+
+// seals tells if rw is encrypted when saved.
+func (e *EnvelopeStore) seals(rw types.ReaderWriter) bool {
+	switch rw.(type) {
+	case *types.Vars:
+		return true
+	case *types.StateVersion:
+		return e.state
+	}
+
+	return false
+}
+
+// opens tells if rw may have been encrypted, and is to be decrypted when read.
+func opens(rw types.ReaderWriter) bool {
+	switch rw.(type) {
+	case *types.Vars, *types.StateVersion:
+		return true
+	}
+
+	return false
+}
+
+// SecretKey tells if a key holds the state of a Layout, or a Plan of a Job, which
+// carries the same values as the state does.
+func SecretKey(key string) bool {
+	if strings.HasPrefix(key, types.STATE+"/") {
+		return true
+	}
+
+	// workspaces/<workspace>/jobs/<layout>/<job>/plan
+	parts := strings.Split(key, "/")
+	if len(parts) != 6 || parts[0] != types.WORKSPACE || parts[2] != types.JOB {
+		return false
+	}
+
+	return parts[5] == types.PLAN || parts[5] == types.PLANFILE
+}
+
+func (e *EnvelopeStore) Save(source types.ReaderWriter, tree *types.Tree) error {
+	s, err := e.seal(source)
+	if err != nil {
+		return err
+	}
+
+	return e.Storer.Save(s, tree)
+}
+
+func (e *EnvelopeStore) SaveTag(source types.ReaderWriter, tree *types.Tree, tag string) error {
+	s, err := e.seal(source)
+	if err != nil {
+		return err
+	}
+
+	return e.Storer.SaveTag(s, tree, tag)
+}
+
+// seal returns what is to be saved in place of source, encrypted if it is sealed.
+func (e *EnvelopeStore) seal(source types.ReaderWriter) (types.ReaderWriter, error) {
+	if !e.seals(source) {
+		return source, nil
+	}
+
+	b, err := source.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot Marshal vars")
+	}
+
+	sb, err := e.keys.Seal(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sealed{ReaderWriter: source, b: sb}, nil
+}
+
+func (e *EnvelopeStore) Get(reader types.ReaderWriter, tree *types.Tree) error {
+	return e.GetVersion(reader, tree, "latest")
+}
+
+func (e *EnvelopeStore) GetVersion(reader types.ReaderWriter, tree *types.Tree, version string) error {
+	if !opens(reader) {
+		return e.Storer.GetVersion(reader, tree, version)
+	}
+
+	s := &sealed{ReaderWriter: reader}
+	if err := e.Storer.GetVersion(s, tree, version); err != nil {
+		return err
+	}
+
+	b, err := e.keys.Open(s.b)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot decrypt %v", path.Join(reader.MakePath(tree), version))
+	}
+
+	return reader.Unmarshal(b)
+}
+
+func (e *EnvelopeStore) GetKey(key string) ([]byte, error) {
+	b, err := e.Storer.GetKey(key)
+	if err != nil || len(b) == 0 || !SecretKey(key) {
+		return b, err
+	}
+
+	out, err := e.keys.Open(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot decrypt %v", key)
+	}
+
+	return out, nil
+}
+
+func (e *EnvelopeStore) SaveKey(key string, val []byte) error {
+	if !e.state || len(val) == 0 || !SecretKey(key) {
+		return e.Storer.SaveKey(key, val)
+	}
+
+	sb, err := e.keys.Seal(val)
+	if err != nil {
+		return err
+	}
+
+	return e.Storer.SaveKey(key, sb)
+}
+
+// RotateVars encrypts every version of the Vars of a tree that is not already
+// encrypted with the primary key, in place. Returns the number of versions rotated.
+func (e *EnvelopeStore) RotateVars(tree *types.Tree) (int, error) {
+	return e.rotate(&types.Vars{}, tree)
+}
+
+// RotateState of a Layout, along with its snapshots. State that is not encrypted is
+// only encrypted if EncryptState is on. Returns the number of values rotated.
+func (e *EnvelopeStore) RotateState(wID, lID string) (int, error) {
+	n, err := e.rotate(&types.StateVersion{}, types.MakeTree(wID, lID))
+	if err != nil {
+		return n, err
+	}
+
+	rotated, err := e.rotateKey(path.Join(types.STATE, wID, lID))
+	if rotated {
+		n++
+	}
+
+	return n, err
+}
+
+// RotatePlans of the Jobs of a Workspace, like RotateState. Returns the number of
+// Plans rotated.
+func (e *EnvelopeStore) RotatePlans(wID string) (int, error) {
+	n := 0
+
+	layouts, err := e.Storer.GetKeys(path.Join(types.WORKSPACE, wID, types.JOB)+"/", "/")
+	if err != nil {
+		return n, err
+	}
+
+	for _, l := range layouts {
+		jobs, err := e.Storer.GetKeys(l, "/")
+		if err != nil {
+			return n, err
+		}
+
+		for _, j := range jobs {
+			for _, leaf := range []string{types.PLAN, types.PLANFILE} {
+				rotated, err := e.rotateKey(path.Join(j, leaf))
+				if err != nil {
+					return n, err
+				}
+
+				if rotated {
+					n++
+				}
+			}
+		}
+	}
+
+	return n, nil
+}
+
+// rotateKey encrypts the value of a key with the primary key, if it is stale.
+// Returns true if it was.
+func (e *EnvelopeStore) rotateKey(key string) (bool, error) {
+	b, err := e.Storer.GetKey(key)
+	if err != nil || !e.stale(b, e.state) {
+		return false, err
+	}
+
+	plain, err := e.keys.Open(b)
+	if err != nil {
+		return false, errors.Wrapf(err, "Cannot decrypt %v", key)
+	}
+
+	sb, err := e.keys.Seal(plain)
+	if err != nil {
+		return false, err
+	}
+
+	if err := e.Storer.SaveKey(key, sb); err != nil {
+		return false, errors.Wrapf(err, "Cannot save %v", key)
+	}
+
+	return true, nil
+}
+
+// stale tells if a value is to be encrypted with the primary key. Values that are not
+// encrypted yet are only if plain is set.
+func (e *EnvelopeStore) stale(b []byte, plain bool) bool {
+	if len(b) == 0 || e.keys.Current(b) {
+		return false
+	}
+
+	_, ok := parse(b)
+	return ok || plain
+}
+
+func (e *EnvelopeStore) rotate(rw types.ReaderWriter, tree *types.Tree) (int, error) {
+	versions, err := e.Storer.GetVersions(rw, tree)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, version := range versions {
+		// Keys like the Consul lock of the Vars live alongside the versions.
+		if _, err := strconv.ParseInt(version, 10, 64); err != nil && version != "latest" {
+			continue
+		}
+
+		key := path.Join(rw.MakePath(tree), version)
+
+		s := &sealed{ReaderWriter: rw}
+		if err := e.Storer.GetVersion(s, tree, version); err != nil {
+			return n, err
+		}
+
+		if !e.stale(s.b, e.seals(rw)) {
+			continue
+		}
+
+		b, err := e.keys.Open(s.b)
+		if err != nil {
+			return n, errors.Wrapf(err, "Cannot decrypt %v", key)
+		}
+
+		sb, err := e.keys.Seal(b)
+		if err != nil {
+			return n, err
+		}
+
+		if err := e.Storer.SaveKey(key, sb); err != nil {
+			return n, errors.Wrapf(err, "Cannot save %v", key)
+		}
+
+		n++
+	}
+
+	return n, nil
+}
@@ -12,12 +12,16 @@ type Storer interface {
 	SaveKey(string, []byte) error
 	GetKeys(prefix string, separator string) ([]string, error)
 	Save(reader types.ReaderWriter, tree *types.Tree) error
+	SaveTag(reader types.ReaderWriter, tree *types.Tree, tag string) error
 	Get(reader types.ReaderWriter, tree *types.Tree) error
 	GetVersion(reader types.ReaderWriter, tree *types.Tree, version string) error
 	GetVersions(reader types.ReaderWriter, tree *types.Tree) ([]string, error)
 
+	DeleteKey(key string) error
 	DeleteKeys(prefix string) error
 
 	Lock(key, s string) error
 	Unlock(key string) error
+	UnlockIf(key, s string) error
+	GetLock(key string) (string, error)
 }
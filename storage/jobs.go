@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// GetJob of a Layout in a Workspace.
+// Reads the latest record from the Job's history, falls back to the one saved at the
+// time of creation if the Job hasn't moved since.
+func GetJob(s Storer, wID, lID, jID string) (*types.Job, error) {
+	tree := types.MakeTree(wID)
+	j := types.Job{Id: jID, LayoutId: lID}
+
+	err := s.Get(&types.JobHistory{Job: &j}, tree)
+	if err == nil {
+		return &j, nil
+	}
+
+	if !strings.Contains(err.Error(), "Missing") {
+		return nil, errors.Wrap(err, "Cannot Load job history")
+	}
+
+	if err := s.GetVersion(&j, tree, jID); err != nil {
+		return nil, err
+	}
+
+	// The first record is marshalled before an Id is assigned to it.
+	j.Id = jID
+	return &j, nil
+}
+
+// SaveJob as a new version in its history.
+func SaveJob(s Storer, wID string, j *types.Job) error {
+	return s.Save(&types.JobHistory{Job: j}, types.MakeTree(wID))
+}
@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/tsocial/tessellate/storage/types"
+)
+
+// SaveLatest saves the data as its latest version only, without a version of its own.
+// For bookkeeping that changes all the time and has no use for a history.
+func SaveLatest(s Storer, source types.ReaderWriter, tree *types.Tree) error {
+	b, err := source.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "Cannot Marshal")
+	}
+
+	return s.SaveKey(path.Join(source.MakePath(tree), "latest"), b)
+}
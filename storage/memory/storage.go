@@ -85,7 +85,8 @@ func (e *BoltStore) Get(reader types.ReaderWriter, tree *types.Tree) error {
 	return e.GetVersion(reader, tree, "latest")
 }
 
-// GetKeys gets all the keys under a given Prefix
+// GetKeys gets all the keys under a given Prefix, up to the next separator.
+// Like Consul, a key is listed even if it is only a prefix of deeper keys.
 func (e *BoltStore) GetKeys(prefix string, separator string) ([]string, error) {
 	keys := map[string]bool{}
 	err := e.db.View(func(tx *bolt.Tx) error {
@@ -95,7 +96,7 @@ func (e *BoltStore) GetKeys(prefix string, separator string) ([]string, error) {
 		for k, _ := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, _ = c.Next() {
 			splitByKey := strings.SplitAfter(string(k), prefix)
 			split := strings.Split(splitByKey[1], separator)
-			if len(split) == 2 {
+			if len(split) >= 2 {
 				keys[splitByKey[0]+split[0]+separator] = true
 			}
 		}
@@ -138,16 +139,21 @@ func (e *BoltStore) GetVersion(reader types.ReaderWriter, tree *types.Tree, vers
 // NOTE: This is an atomic operation, so either everything is written or nothing is.
 // The operation may take its own sweet time before a quorum write is guaranteed.
 func (e *BoltStore) Save(source types.ReaderWriter, tree *types.Tree) error {
+	ts := time.Now().UnixNano()
+	return e.SaveTag(source, tree, fmt.Sprintf("%+v", ts))
+}
+
+// SaveTag saves the data as the given version, and as the latest one.
+func (e *BoltStore) SaveTag(source types.ReaderWriter, tree *types.Tree, ts string) error {
 	b, err := source.Marshal()
 	if err != nil {
 		return errors.Wrap(err, "Cannot Marshal vars")
 	}
 
-	ts := time.Now().UnixNano()
 	key := source.MakePath(tree)
 
 	latestKey := path.Join(key, "latest")
-	timestampKey := path.Join(key, fmt.Sprintf("%+v", ts))
+	timestampKey := path.Join(key, ts)
 
 	if err := e.db.Update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(e.bucket)
@@ -160,7 +166,7 @@ func (e *BoltStore) Save(source types.ReaderWriter, tree *types.Tree) error {
 		return errors.New("Txn was rolled back. Weird, huh")
 	}
 
-	source.SaveId(fmt.Sprintf("%v", ts))
+	source.SaveId(ts)
 
 	return nil
 }
@@ -197,15 +203,60 @@ func (e *BoltStore) Unlock(key string) error {
 	})
 }
 
+// UnlockIf unlocks the key only if it is still locked with s.
+// Does not raise error if the key isn't locked.
+func (e *BoltStore) UnlockIf(key, s string) error {
+	return e.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(e.bucket)
+		if b := bucket.Get([]byte(key)); len(b) > 0 && string(b) != s {
+			return errors.Errorf("Key %v is locked by %v", key, string(b))
+		}
+		return bucket.Delete([]byte(key))
+	})
+}
+
+// GetLock returns the value a key was locked with, empty if it isn't locked.
+func (e *BoltStore) GetLock(key string) (string, error) {
+	b, err := e.GetKey(key)
+	return string(b), err
+}
+
 // Teardown has not been implemented yet.
 func (e *BoltStore) Teardown() error {
 	return nil
 }
 
-// DeleteKeys is actually DeleteBucket when in BoltDB
-// It was just introduced for Consul designed tests.
+// DeleteKey deletes a single Key.
+// Does not raise error if the Key is absent.
+func (e *BoltStore) DeleteKey(key string) error {
+	return e.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(e.bucket).Delete([]byte(key))
+	})
+}
+
+// DeleteKeys deletes all the Keys under a given prefix.
+// Like Consul, the prefix is taken to be a directory.
 func (e *BoltStore) DeleteKeys(prefix string) error {
 	return e.db.Update(func(tx *bolt.Tx) error {
-		return tx.DeleteBucket([]byte(prefix))
+		b := tx.Bucket(e.bucket)
+		if b == nil {
+			return nil
+		}
+
+		// Deleting while iterating makes the Cursor skip Keys.
+		keys := [][]byte{}
+		p := []byte(prefix + "/")
+		c := b.Cursor()
+		for k, _ := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, _ = c.Next() {
+			keys = append(keys, k)
+		}
+
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
 	})
 }
@@ -27,15 +27,41 @@ func TestStorer(t *testing.T) {
 			assert.NotNil(t, err, "Should have raised a key")
 		})
 
+		t.Run("Get the value of a Lock", func(t *testing.T) {
+			v, err := store.GetLock("key3")
+			assert.Nil(t, err)
+			assert.Equal(t, "c1", v)
+		})
+
 		t.Run("Release a Key", func(t *testing.T) {
 			err := store.Unlock("key3")
 			assert.Nil(t, err)
+
+			v, err := store.GetLock("key3")
+			assert.Nil(t, err)
+			assert.Empty(t, v)
 		})
 
 		t.Run("Idempotent Release a Key", func(t *testing.T) {
 			err := store.Unlock("key3")
 			assert.Nil(t, err)
 		})
+
+		t.Run("Release a Key only if it is held with a value", func(t *testing.T) {
+			assert.Nil(t, store.Lock("key4", "c1"))
+
+			assert.NotNil(t, store.UnlockIf("key4", "c2"))
+			v, err := store.GetLock("key4")
+			assert.Nil(t, err)
+			assert.Equal(t, "c1", v)
+
+			assert.Nil(t, store.UnlockIf("key4", "c1"))
+			v, err = store.GetLock("key4")
+			assert.Nil(t, err)
+			assert.Empty(t, v)
+
+			assert.Nil(t, store.UnlockIf("key4", "c1"))
+		})
 	})
 
 	t.Run("Storage tests", func(t *testing.T) {
@@ -129,5 +155,38 @@ func TestStorer(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, val, string(got))
 		})
+
+		t.Run("Delete Keys", func(t *testing.T) {
+			prefix := uuid.NewV4().String()
+			for _, k := range []string{"a", "b/c", "b/d"} {
+				assert.Nil(t, store.SaveKey(prefix+"/"+k, []byte("value")))
+			}
+
+			other := prefix + "-other/a"
+			assert.Nil(t, store.SaveKey(other, []byte("value")))
+
+			assert.Nil(t, store.DeleteKeys(prefix))
+
+			keys, err := store.GetKeys(prefix+"/", "/")
+			assert.Nil(t, err)
+			assert.Empty(t, keys)
+
+			got, err := store.GetKey(other)
+			assert.Nil(t, err)
+			assert.Equal(t, "value", string(got))
+		})
+
+		t.Run("Delete Key", func(t *testing.T) {
+			key := uuid.NewV4().String()
+			assert.Nil(t, store.SaveKey(key, []byte("value")))
+			assert.Nil(t, store.DeleteKey(key))
+
+			got, err := store.GetKey(key)
+			assert.Nil(t, err)
+			assert.Equal(t, []byte{}, got)
+
+			// Deleting an absent Key is not an error.
+			assert.Nil(t, store.DeleteKey(key))
+		})
 	})
 }
@@ -2,10 +2,11 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"path"
-	"strings"
 
 	"github.com/satori/go.uuid"
+	"github.com/tsocial/tessellate/redact"
 )
 
 const (
@@ -15,10 +16,21 @@ const (
 	VAR       = "vars"
 	WATCH     = "watch"
 	STATE     = "state"
+	HISTORY   = "history"
+	LOGS      = "logs"
+	PLAN      = "plan"
+	PLANFILE  = "planfile"
+	QUEUE     = "queue"
+	DRIFT     = "drift"
+	SCHEDULE  = "schedule"
+	SNAPSHOT  = "snapshots"
+	LOCK      = "lock"
+	AUDIT     = "audit"
+	STACK     = "stacks"
+	RUN       = "runs"
+	PROMOTION = "promotions"
 )
 
-var secretKeys = []string{"secret", "access"}
-
 // MakeTree populates a Tree based on Input.
 // Tree in itself is quite vague (read generic), but consumption is specific to workspace
 // and layouts.
@@ -94,52 +106,13 @@ func (w *Vars) Unmarshal(b []byte) error {
 	return json.Unmarshal(b, w)
 }
 
-func (w *Vars) RedactSecrets() {
+// RedactSecrets masks the values that are secrets by the rules of a Redactor.
+func (w *Vars) RedactSecrets(r *redact.Redactor) {
 	if len(*w) == 0 {
 		return
 	}
 
-	mv := map[string]interface{}(*w)
-	for k := range mv {
-		mv[k] = redactSecrets(mv[k])
-	}
-}
-
-func redactSecrets(m interface{}) interface{} {
-	mp, ok := m.(map[string]interface{})
-	if ok {
-		for k := range mp {
-			if _, ok := mp[k].(map[string]interface{}); ok {
-				mp[k] = redactSecrets(mp[k])
-				continue
-			}
-
-			if _, ok := mp[k].([]interface{}); ok {
-				mp[k] = redactSecrets(mp[k])
-				continue
-			}
-
-			_, ok = mp[k].(string)
-			for _, s := range secretKeys {
-				if strings.Contains(strings.ToLower(k), s) && ok {
-					mp[k] = "***********"
-				}
-			}
-		}
-		return mp
-	}
-
-	ml, ok := m.([]interface{})
-	if ok {
-		for ix, i := range ml {
-			if _, ok := i.(map[string]interface{}); ok {
-				ml[ix] = redactSecrets(ml[ix])
-			}
-		}
-		return ml
-	}
-
-	return m
+	r.Value(map[string]interface{}(*w))
 }
 
 func (w *Vars) Marshal() ([]byte, error) {
@@ -150,10 +123,19 @@ type Layout struct {
 	Id     string                     `json:"id"`
 	Plan   map[string]json.RawMessage `json:"plan"`
 	Status int32                      `json:"status"`
+
+	// Workspace and version of the Layout it was promoted from, if it was.
+	SourceWorkspace string `json:"source_workspace,omitempty"`
+	SourceVersion   string `json:"source_version,omitempty"`
+
+	// Version the Layout was saved as.
+	Version string `json:"-"`
 	*BaseType
 }
 
-func (l *Layout) SaveId(string) {}
+func (l *Layout) SaveId(id string) {
+	l.Version = id
+}
 
 func (l *Layout) MakePath(n *Tree) string {
 	return path.Join(n.MakePath(), LAYOUT, l.Id)
@@ -180,8 +162,39 @@ type Job struct {
 	Op            int32  `json:"op"`
 	Dry           bool   `json:"dry"`
 	Retry         int64  `json:"retry"`
+	Error         string `json:"error,omitempty"`
+	StartedAt     int64  `json:"started_at,omitempty"`
+	EndedAt       int64  `json:"ended_at,omitempty"`
+
+	// Dry Job whose saved plan is to be applied.
+	PlanJobId string `json:"plan_job_id,omitempty"`
+
+	// Serial of the state when the Job started.
+	StateSerial int64 `json:"state_serial,omitempty"`
+
+	// Plan only Job, made to detect drift of the Layout from its state.
+	Drift bool `json:"drift,omitempty"`
+
+	// Resource addresses to limit the Job to, and to force to be created again.
+	Targets []string `json:"targets,omitempty"`
+	Replace []string `json:"replace,omitempty"`
+
+	// Address in the Layout, and provider ID, of the resource to import.
+	ImportAddress string `json:"import_address,omitempty"`
+	ImportId      string `json:"import_id,omitempty"`
 }
 
+// States of a Job, that its Status is one of. The same as the JobState of the API.
+const (
+	JobPending int32 = iota
+	JobRunning
+	JobFailed
+	JobAborted
+	JobDone
+	JobError
+	JobQueued
+)
+
 func (v *Job) SaveId(id string) {
 	v.Id = id
 }
@@ -198,6 +211,60 @@ func (w *Job) Marshal() ([]byte, error) {
 	return json.Marshal(w)
 }
 
+// JobHistory saves a copy of the Job every time it moves to a new state.
+// Versions are kept under the Job's own key, so that the Job ID remains the one
+// it was created with.
+type JobHistory struct {
+	*Job
+}
+
+func (v *JobHistory) SaveId(string) {}
+
+func (v *JobHistory) MakePath(n *Tree) string {
+	return path.Join(v.Job.MakePath(n), v.Id, HISTORY)
+}
+
+// PlanKey is where the JSON Plan made by a dry Job is saved.
+func (v *Job) PlanKey(n *Tree) string {
+	return path.Join(v.MakePath(n), v.Id, PLAN)
+}
+
+// PlanFileKey is where the binary Plan made by a dry Job is saved, to be applied as is.
+func (v *Job) PlanFileKey(n *Tree) string {
+	return path.Join(v.MakePath(n), v.Id, PLANFILE)
+}
+
+// LogKey is where the nth chunk of a Job's output is saved.
+func (v *Job) LogKey(n *Tree, seq int) string {
+	return path.Join(v.MakePath(n), v.Id, LOGS, fmt.Sprintf("%08d", seq))
+}
+
+// QueuedJob marks a Job waiting for its turn to run on a Layout.
+// Markers are saved under the Layout tree and keyed by the Job ID, which being a
+// timestamp orders the queue.
+type QueuedJob struct {
+	Id string `json:"id"`
+}
+
+func (q *QueuedJob) SaveId(string) {}
+
+func (q *QueuedJob) MakePath(n *Tree) string {
+	return path.Join(n.MakePath(), QUEUE)
+}
+
+// Key of the marker.
+func (q *QueuedJob) Key(n *Tree) string {
+	return path.Join(q.MakePath(n), q.Id)
+}
+
+func (q *QueuedJob) Unmarshal(b []byte) error {
+	return json.Unmarshal(b, q)
+}
+
+func (q *QueuedJob) Marshal() ([]byte, error) {
+	return json.Marshal(q)
+}
+
 type Watch struct {
 	Id         string
 	SuccessURL string `json:"success_url"`
@@ -219,3 +286,232 @@ func (w *Watch) Unmarshal(b []byte) error {
 func (w *Watch) Marshal() ([]byte, error) {
 	return json.Marshal(w)
 }
+
+// DriftSchedule is a cron expression for how often a Layout is checked for drift.
+type DriftSchedule struct {
+	Cron string `json:"cron"`
+
+	// Time the last check was due, and the Job that was made for it.
+	LastRun   int64  `json:"last_run"`
+	LastJobId string `json:"last_job_id,omitempty"`
+}
+
+func (d *DriftSchedule) SaveId(string) {}
+
+func (d *DriftSchedule) MakePath(n *Tree) string {
+	return path.Join(n.MakePath(), DRIFT, SCHEDULE)
+}
+
+func (d *DriftSchedule) Unmarshal(b []byte) error {
+	return json.Unmarshal(b, d)
+}
+
+func (d *DriftSchedule) Marshal() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+// Drift is the result of the latest drift check of a Layout.
+type Drift struct {
+	JobId     string `json:"job_id"`
+	Drifted   bool   `json:"drifted"`
+	CheckedAt int64  `json:"checked_at"`
+
+	// Addresses of the resources that have drifted.
+	Resources []string `json:"resources,omitempty"`
+}
+
+func (d *Drift) SaveId(string) {}
+
+func (d *Drift) MakePath(n *Tree) string {
+	return path.Join(n.MakePath(), DRIFT)
+}
+
+func (d *Drift) Unmarshal(b []byte) error {
+	return json.Unmarshal(b, d)
+}
+
+func (d *Drift) Marshal() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+// Stages of a StateVersion, that its Stage is one of. The same as the StateStage of the API.
+const (
+	StageBeforeJob int32 = iota
+	StageAfterJob
+	StageRestored
+)
+
+// StateVersion is a snapshot of the state of a Layout, taken around every Job and
+// every restore.
+type StateVersion struct {
+	Id        string `json:"id"`
+	JobId     string `json:"job_id,omitempty"`
+	Stage     int32  `json:"stage"`
+	Serial    int64  `json:"serial"`
+	State     []byte `json:"state"`
+	CreatedAt int64  `json:"created_at"`
+
+	// Version the state was restored from, and who restored it.
+	RestoredFrom string `json:"restored_from,omitempty"`
+	RestoredBy   string `json:"restored_by,omitempty"`
+}
+
+func (v *StateVersion) SaveId(id string) {
+	v.Id = id
+}
+
+func (v *StateVersion) MakePath(n *Tree) string {
+	return path.Join(n.MakePath(), SNAPSHOT)
+}
+
+func (v *StateVersion) Unmarshal(b []byte) error {
+	return json.Unmarshal(b, v)
+}
+
+func (v *StateVersion) Marshal() ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// LockInfo records which Job, or operation, a Layout was locked for and when.
+// The Lock itself only holds the Job ID.
+type LockInfo struct {
+	Holder   string `json:"holder"`
+	LockedAt int64  `json:"locked_at"`
+}
+
+func (l *LockInfo) SaveId(string) {}
+
+func (l *LockInfo) MakePath(n *Tree) string {
+	return path.Join(n.MakePath(), LOCK)
+}
+
+func (l *LockInfo) Unmarshal(b []byte) error {
+	return json.Unmarshal(b, l)
+}
+
+func (l *LockInfo) Marshal() ([]byte, error) {
+	return json.Marshal(l)
+}
+
+// AuditEvent records an admin operation on a Workspace, and who performed it.
+type AuditEvent struct {
+	Id        string `json:"id"`
+	Action    string `json:"action"`
+	Actor     string `json:"actor"`
+	LayoutId  string `json:"layout_id,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+func (a *AuditEvent) SaveId(id string) {
+	a.Id = id
+}
+
+// MakePath of the events of a Workspace, apart from its keys, so that they outlive it.
+func (a *AuditEvent) MakePath(n *Tree) string {
+	return path.Join(AUDIT, n.Name)
+}
+
+func (a *AuditEvent) Unmarshal(b []byte) error {
+	return json.Unmarshal(b, a)
+}
+
+func (a *AuditEvent) Marshal() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+// Stack of Layouts of a Workspace, applied in the order of their dependencies.
+type Stack struct {
+	Id      string        `json:"id"`
+	Layouts []StackLayout `json:"layouts"`
+}
+
+// StackLayout is a Layout of a Stack, and the Layouts of the Stack it depends on.
+type StackLayout struct {
+	Id        string   `json:"id"`
+	DependsOn []string `json:"depends_on,omitempty"`
+}
+
+func (s *Stack) SaveId(string) {}
+
+func (s *Stack) MakePath(n *Tree) string {
+	return path.Join(n.MakePath(), STACK, s.Id)
+}
+
+func (s *Stack) Unmarshal(b []byte) error {
+	return json.Unmarshal(b, s)
+}
+
+func (s *Stack) Marshal() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// StackRun applies, or destroys, the Layouts of a Stack a layer at a time. A layer is
+// dispatched once every Job of the one before it is done.
+type StackRun struct {
+	Id      string     `json:"id"`
+	StackId string     `json:"stack_id"`
+	Op      int32      `json:"op"`
+	Status  int32      `json:"status"`
+	Retry   int64      `json:"retry"`
+	Layers  [][]string `json:"layers"`
+	Layer   int32      `json:"layer"`
+	Error   string     `json:"error,omitempty"`
+
+	// Jobs dispatched so far, by Layout ID.
+	Jobs map[string]string `json:"jobs,omitempty"`
+}
+
+func (r *StackRun) SaveId(id string) {
+	r.Id = id
+}
+
+func (r *StackRun) MakePath(n *Tree) string {
+	return path.Join(n.MakePath(), STACK, r.StackId, RUN)
+}
+
+func (r *StackRun) Unmarshal(b []byte) error {
+	return json.Unmarshal(b, r)
+}
+
+func (r *StackRun) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// StackRunHistory saves a copy of the StackRun every time it moves on, like JobHistory.
+type StackRunHistory struct {
+	*StackRun
+}
+
+func (r *StackRunHistory) SaveId(string) {}
+
+func (r *StackRunHistory) MakePath(n *Tree) string {
+	return path.Join(r.StackRun.MakePath(n), r.Id, HISTORY)
+}
+
+// Promotion of a version of a Layout from another Workspace, to a new version of the Layout.
+// Promotions are saved under the Layout tree and keyed by when they were made.
+type Promotion struct {
+	Id              string `json:"id"`
+	LayoutId        string `json:"layout_id"`
+	SourceWorkspace string `json:"source_workspace"`
+	SourceVersion   string `json:"source_version"`
+	Version         string `json:"version"`
+	PromotedBy      string `json:"promoted_by,omitempty"`
+}
+
+func (p *Promotion) SaveId(id string) {
+	p.Id = id
+}
+
+func (p *Promotion) MakePath(n *Tree) string {
+	return path.Join(n.MakePath(), PROMOTION)
+}
+
+func (p *Promotion) Unmarshal(b []byte) error {
+	return json.Unmarshal(b, p)
+}
+
+func (p *Promotion) Marshal() ([]byte, error) {
+	return json.Marshal(p)
+}
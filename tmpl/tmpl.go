@@ -2,12 +2,74 @@ package tmpl
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/pkg/errors"
 	"github.com/flosch/pongo2"
 )
 
+// Var that the outputs of other Layouts are rendered with, like {{ outputs["network"].vpc_id }}.
+const OutputsVar = "outputs"
+
+// outputRef matches a reference to the outputs of a Layout. Terraform's own
+// data.x.outputs["name"] is left alone.
+var outputRef = regexp.MustCompile(`(^|[^\w.])` + OutputsVar + `\[\s*["']([^"']+)["']\s*\]`)
+
+// OutputRefs returns the Layouts that a template refers to the outputs of.
+func OutputRefs(data json.RawMessage) []string {
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, m := range outputRef.FindAllStringSubmatch(source(data), -1) {
+		if id := m[2]; !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// source of a template, which is either a JSON string or the JSON itself.
+func source(data json.RawMessage) string {
+	var x string
+	if err := json.Unmarshal(data, &x); err != nil {
+		x = string(data)
+	}
+
+	return x
+}
+
+// bindOutputs rewrites the references to the outputs of Layouts, which pongo2 cannot
+// subscript, to vars of their own. The vars are copied, not changed.
+func bindOutputs(x string, vars pongo2.Context) (string, pongo2.Context) {
+	outputs, _ := vars[OutputsVar].(map[string]interface{})
+	if outputs == nil {
+		return x, vars
+	}
+
+	bound := pongo2.Context{}
+	for k, v := range vars {
+		bound[k] = v
+	}
+
+	names := map[string]string{}
+	x = outputRef.ReplaceAllStringFunc(x, func(ref string) string {
+		m := outputRef.FindStringSubmatch(ref)
+		name, ok := names[m[2]]
+		if !ok {
+			name = fmt.Sprintf("__%v_%d__", OutputsVar, len(names))
+			names[m[2]] = name
+			bound[name] = outputs[m[2]]
+		}
+
+		return m[1] + name
+	})
+
+	return x, bound
+}
+
 // Check if the bytes will yield a hash
 func isJSON(b []byte) bool {
 	var js interface{}
@@ -18,21 +80,15 @@ func isJSON(b []byte) bool {
 // Parse the given bytes for the vars supplied.
 // Return rendered bytes, only if they will yield a valid hash.
 func ParseLayout(data json.RawMessage, vars pongo2.Context) ([]byte, error) {
-	var x string
-	if err := json.Unmarshal(data, &x); err != nil {
-		x = string(data)
-	}
-
-	out, err := Parse(x, vars)
+	out, err := Parse(bindOutputs(source(data), vars))
 	if err != nil {
 		return nil, errors.Wrap(err, "Cannot parse template.")
 	}
 
 	outBytes := []byte(out)
 	if !isJSON(outBytes) {
+		// Vars, and so what was rendered with them, may hold secrets.
 		log.Println(string(data))
-		log.Println(vars)
-		log.Println(out)
 
 		return nil, errors.New("Rendered layout is not a valid JSON.")
 	}
@@ -48,3 +48,31 @@ func TestTmpl(t *testing.T) {
 		assert.Equal(t, false, strings.Contains(string(out), "012"))
 	})
 }
+
+func TestOutputRefs(t *testing.T) {
+	t.Run("Should find the Layouts referred to", func(t *testing.T) {
+		d := json.RawMessage(`"{\"vpc\": \"{{ outputs['network'].vpc_id }}\", \"db\": {{ outputs[\"db\"].hosts|safe }}, \"subnet\": \"{{ outputs[ 'network' ].subnet }}\"}"`)
+		assert.Equal(t, []string{"network", "db"}, OutputRefs(d))
+	})
+
+	t.Run("Should leave Terraform's remote state outputs alone", func(t *testing.T) {
+		d := json.RawMessage(`{"vpc": "${data.terraform_remote_state.network.outputs[\"vpc_id\"]}"}`)
+		assert.Empty(t, OutputRefs(d))
+	})
+
+	t.Run("Should render the outputs", func(t *testing.T) {
+		d := json.RawMessage(`{"vpc": "{{ outputs['network'].vpc_id }}"}`)
+		out, err := ParseLayout(d, pongo2.Context{
+			OutputsVar: map[string]interface{}{"network": map[string]interface{}{"vpc_id": "vpc-123"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"vpc": "vpc-123"}`, string(out))
+
+		d = json.RawMessage(`"{\"ids\": {{ outputs[\"core-network\"].subnet_ids|stringformat:'%q'|safe }}}"`)
+		out, err = ParseLayout(d, pongo2.Context{
+			OutputsVar: map[string]interface{}{"core-network": map[string]interface{}{"subnet_ids": "subnet-1"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"ids": "subnet-1"}`, string(out))
+	})
+}